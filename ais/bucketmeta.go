@@ -91,6 +91,7 @@ type (
 		needReEC     bool
 		terminate    bool
 		singleTarget bool
+		cloneProps   bool // bmodCpProps: inherit remote source props
 	}
 )
 
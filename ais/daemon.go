@@ -12,6 +12,7 @@ import (
 	"runtime"
 	"strings"
 
+	"github.com/NVIDIA/aistore/ais/s3"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/atomic"
@@ -224,6 +225,7 @@ func initDaemon(version, buildTime string) cos.Runner {
 	// reg xaction factories
 	xs.Xreg(false /* x-ele only */)
 	space.Xreg()
+	s3.Xreg()
 
 	t := newTarget(co)
 	t.init(config)
@@ -549,13 +549,13 @@ func (server *netServer) listen(addr string, logger *log.Logger, tlsConf *tls.Co
 	if timeout, isSet := cmn.ParseReadHeaderTimeout(); isSet { // optional env var
 		server.s.ReadHeaderTimeout = timeout
 	}
-	if server.sndRcvBufSize > 0 && !config.Net.HTTP.UseHTTPS {
+	if server.sndRcvBufSize > 0 && tlsConf == nil {
 		server.s.ConnState = server.connStateListener // setsockopt; see also cmn.NewTransport
 	}
 	server.s.TLSConfig = tlsConf
 	server.Unlock()
 retry:
-	if config.Net.HTTP.UseHTTPS {
+	if tlsConf != nil {
 		tag = "HTTPS"
 		err = server.s.ListenAndServeTLS(config.Net.HTTP.Certificate, config.Net.HTTP.CertKey)
 	} else {
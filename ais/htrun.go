@@ -328,9 +328,12 @@ func (h *htrun) initSnode(config *cmn.Config) {
 		nlog.Infof("%s access: %v%s", cmn.NetIntraControl, ctrlAddr, s)
 	}
 	dataAddr = pubAddr
+	if config.Net.HTTP.IntraDataTLS && !config.Net.HTTP.UseHTTPS && !config.HostNet.UseIntraData {
+		cos.ExitLogf("net.http.intra_data_tls requires separate %s network (host_net.port_intra_data)", cmn.NetIntraData)
+	}
 	if config.HostNet.UseIntraData {
 		idport := strconv.Itoa(config.HostNet.PortIntraData)
-		err = initNetInfo(&dataAddr, addrList, proto, config.HostNet.HostnameIntraData, idport)
+		err = initNetInfo(&dataAddr, addrList, config.Net.HTTP.DataProto(), config.HostNet.HostnameIntraData, idport)
 		if err != nil {
 			cos.ExitLogf("failed to get %s IPv4/hostname: %v", cmn.NetIntraData, err)
 		}
@@ -368,10 +371,18 @@ func (h *htrun) initSnode(config *cmn.Config) {
 	)
 
 	// 4. new Snode
+	caps, err := meta.ParseCaps(config.Caps)
+	if err != nil {
+		cos.ExitLogf("local config: %v", err)
+	}
 	h.si = &meta.Snode{
 		PubNet:     pubAddr,
 		ControlNet: ctrlAddr,
 		DataNet:    dataAddr,
+		Caps:       caps,
+	}
+	if caps != 0 {
+		nlog.Infoln("capabilities:", h.si.CapNames())
 	}
 	if l := len(pubExtra); l > 0 {
 		h.si.PubExtra = make([]meta.NetInfo, l)
@@ -483,8 +494,16 @@ func (h *htrun) run(config *cmn.Config) error {
 		}()
 	}
 	if config.HostNet.UseIntraData {
+		dataTLS := tlsConf
+		if dataTLS == nil && config.Net.HTTP.IntraDataTLS {
+			c, err := newTLS(&config.Net.HTTP)
+			if err != nil {
+				cos.ExitLog(err)
+			}
+			dataTLS = c
+		}
 		go func() {
-			_ = g.netServ.data.listen(h.si.DataNet.TCPEndpoint(), logger, tlsConf, config)
+			_ = g.netServ.data.listen(h.si.DataNet.TCPEndpoint(), logger, dataTLS, config)
 		}()
 	}
 
@@ -1096,6 +1115,43 @@ func (h *htrun) statsAndStatus() (ds *stats.NodeStatus) {
 	return ds
 }
 
+// apc.WhatNodeStatsAndStatus: selected sections (apc.QparamProps), if any
+type nsSections []string
+
+func newNsSections(query url.Values) nsSections {
+	props := query.Get(apc.QparamProps)
+	if props == "" {
+		return nil
+	}
+	return strings.Split(props, apc.LsPropsSepa)
+}
+
+func (secs nsSections) has(sec string) bool {
+	if len(secs) == 0 {
+		return true
+	}
+	if sec == apc.NodeStatusThroughput && cos.StringInSlice(apc.NodeStatusMetrics, secs) {
+		return true
+	}
+	return cos.StringInSlice(sec, secs)
+}
+
+// all metrics or only throughput
+func (h *htrun) nsMetrics(ds *stats.NodeStatus, secs nsSections) {
+	switch {
+	case secs.has(apc.NodeStatusMetrics):
+		ds.Tracker = h.statsT.GetMetrics().Tracker
+	case secs.has(apc.NodeStatusThroughput):
+		kinds := h.statsT.GetMetricNames()
+		ds.Tracker = h.statsT.GetMetrics().Tracker
+		for name := range ds.Tracker {
+			if kinds[name] != stats.KindThroughput {
+				delete(ds.Tracker, name)
+			}
+		}
+	}
+}
+
 // [backward compatibility] v3.22 and prior
 func (h *htrun) statsAndStatusV322() (ds *stats.NodeStatusV322) {
 	smap := h.owner.smap.get()
@@ -84,7 +84,7 @@ func initDataClient(config *cmn.Config) {
 		WriteBufferSize: wbuf,
 		ReadBufferSize:  rbuf,
 	}
-	if config.Net.HTTP.UseHTTPS {
+	if config.Net.HTTP.UseDataTLS() {
 		g.client.data = cmn.NewIntraClientTLS(cargs, config)
 	} else {
 		g.client.data = cmn.NewClient(cargs)
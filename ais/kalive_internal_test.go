@@ -7,6 +7,9 @@ package ais
 import (
 	"testing"
 	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/core/meta"
 )
 
 func TestHB(t *testing.T) {
@@ -49,3 +52,23 @@ func TestHB(t *testing.T) {
 		t.Fatal("Expecting timeout")
 	}
 }
+
+// node restarted with different capabilities must get updated in the cluster map
+func TestKaliveCapsChanged(t *testing.T) {
+	p := newDiscoverServerPrimary()
+	p.markNodeStarted()
+	var (
+		osi = newSnode("t1", apc.Target, meta.NetInfo{}, meta.NetInfo{}, meta.NetInfo{})
+		nsi = newSnode("t1", apc.Target, meta.NetInfo{}, meta.NetInfo{}, meta.NetInfo{})
+	)
+	if p.kalive(nsi, osi) || p.rereg(nsi, osi) {
+		t.Fatal("expecting no update when node info is unchanged")
+	}
+	nsi.Caps = meta.SnodeCapETL
+	if !p.kalive(nsi, osi) {
+		t.Fatal("keepalive: expecting update upon capabilities change")
+	}
+	if !p.rereg(nsi, osi) {
+		t.Fatal("re-register: expecting update upon capabilities change")
+	}
+}
@@ -1118,6 +1118,10 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 	const (
 		warnDstNotExist = "%s: destination %s doesn't exist and will be created with the %s (source bucket) props"
 		errPrependSync  = "prepend option (%q) is incompatible with the request to synchronize buckets"
+		errSkipExisting = "skip-existing option is incompatible with overwrite, latest-version, and synchronize"
+		errDeleteSrc    = "delete-source option is incompatible with skip-existing and synchronize"
+		errDeleteRemote = "delete-source option requires ais:// source bucket (have %s) - won't delete from remote backend"
+		errLatestVer    = "latest-version option requires remote source bucket (have %s)"
 	)
 	var (
 		query    = r.URL.Query()
@@ -1219,6 +1223,34 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErrf(w, r, errPrependSync, tcbmsg.Prepend)
 			return
 		}
+		if tcbmsg.SkipExisting && (tcbmsg.Overwrite || tcbmsg.LatestVer || tcbmsg.Sync) {
+			p.writeErrMsg(w, r, errSkipExisting)
+			return
+		}
+		if tcbmsg.DeleteSrc && (tcbmsg.SkipExisting || tcbmsg.Sync) {
+			p.writeErrMsg(w, r, errDeleteSrc)
+			return
+		}
+		if tcbmsg.DeleteSrc && bckFrom.IsRemote() {
+			p.writeErrf(w, r, errDeleteRemote, bckFrom)
+			return
+		}
+		if tcbmsg.LatestVer && !bckFrom.IsRemote() {
+			p.writeErrf(w, r, errLatestVer, bckFrom)
+			return
+		}
+		if err := tcbmsg.ValidateLimits(); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if err := tcbmsg.InitRename(); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if err := tcbmsg.ValidateGlobs(); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 		bckTo, err = newBckFromQuname(query, true /*required*/)
 		if err != nil {
 			p.writeErr(w, r, err)
@@ -1263,7 +1295,7 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			xid, err = lstcx.do()
 		} else {
 			nlog.Infoln("x-tcb:", bckFrom.String(), "=>", bckTo.String())
-			xid, err = p.tcb(bckFrom, bckTo, msg, tcbmsg.DryRun)
+			xid, err = p.tcb(bckFrom, bckTo, msg, &tcbmsg.CopyBckMsg)
 		}
 		if err != nil {
 			p.writeErr(w, r, err)
@@ -1284,6 +1316,34 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErrf(w, r, errPrependSync, tcomsg.Prepend)
 			return
 		}
+		if tcomsg.SkipExisting && (tcomsg.Overwrite || tcomsg.LatestVer || tcomsg.Sync) {
+			p.writeErrMsg(w, r, errSkipExisting)
+			return
+		}
+		if tcomsg.DeleteSrc && (tcomsg.SkipExisting || tcomsg.Sync) {
+			p.writeErrMsg(w, r, errDeleteSrc)
+			return
+		}
+		if tcomsg.DeleteSrc && bck.IsRemote() {
+			p.writeErrf(w, r, errDeleteRemote, bck)
+			return
+		}
+		if tcomsg.LatestVer && !bck.IsRemote() {
+			p.writeErrf(w, r, errLatestVer, bck)
+			return
+		}
+		if err := tcomsg.ValidateLimits(); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if err := tcomsg.InitRename(); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if err := tcomsg.ValidateGlobs(); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 		bckTo = meta.CloneBck(&tcomsg.ToBck)
 
 		if bck.Equal(bckTo, true, true) {
@@ -2455,10 +2515,12 @@ func (p *proxy) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		p.htrun.httpdaeget(w, r, query, nil /*htext*/)
 
 	case apc.WhatNodeStatsAndStatus:
+		secs := newNsSections(query)
 		ds := p.statsAndStatus()
-		daeStats := p.statsT.GetStats()
-		ds.Tracker = daeStats.Tracker
-		p.ciiFill(&ds.Cluster)
+		p.nsMetrics(ds, secs)
+		if secs.has(apc.NodeStatusCluster) {
+			p.ciiFill(&ds.Cluster)
+		}
 		p.writeJSON(w, r, ds, what)
 
 	case apc.WhatSysInfo:
@@ -71,7 +71,7 @@ func (p *proxy) httpcluget(w http.ResponseWriter, r *http.Request) {
 	switch what {
 	case apc.WhatAllXactStatus:
 		p.ic.xstatusAll(w, r, query)
-	case apc.WhatQueryXactStats:
+	case apc.WhatQueryXactStats, apc.WhatXactErrNames:
 		p.xquery(w, r, what, query)
 	case apc.WhatAllRunningXacts:
 		p.xgetRunning(w, r, what, query)
@@ -81,6 +81,8 @@ func (p *proxy) httpcluget(w http.ResponseWriter, r *http.Request) {
 		p.qcluSysinfo(w, r, what, query)
 	case apc.WhatMountpaths:
 		p.qcluMountpaths(w, r, what, query)
+	case apc.WhatDiskStats:
+		p.qcluDiskStats(w, r, what, query)
 	case apc.WhatRemoteAIS:
 		all, err := p.getRemAisVec(true /*refresh*/)
 		if err != nil {
@@ -300,6 +302,15 @@ func (p *proxy) qcluMountpaths(w http.ResponseWriter, r *http.Request, what stri
 	p.writeJSON(w, r, out, what)
 }
 
+// all targets' disk stats in one shot (compare w/ api.GetDiskStats)
+func (p *proxy) qcluDiskStats(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
+	targetDiskStats, erred := p._queryTs(w, r, query)
+	if targetDiskStats == nil || erred {
+		return
+	}
+	p.writeJSON(w, r, targetDiskStats, what)
+}
+
 // helper methods for querying targets
 
 func (p *proxy) _queryTs(w http.ResponseWriter, r *http.Request, query url.Values) (cos.JSONRawMsgs, bool) {
@@ -685,6 +696,11 @@ func (p *proxy) kalive(nsi, osi *meta.Snode) bool {
 		nlog.Warningf("%s: renewing registration %s (info changed!)", p, nsi.StringEx())
 		return true // NOTE: update cluster map
 	}
+	if osi.Caps != nsi.Caps {
+		nlog.Warningf("%s: renewing registration %s (capabilities changed: %v => %v)", p, nsi.StringEx(),
+			osi.CapNames(), nsi.CapNames())
+		return true // ditto
+	}
 
 	p.keepalive.heardFrom(nsi.ID())
 	return false
@@ -694,7 +710,7 @@ func (p *proxy) rereg(nsi, osi *meta.Snode) bool {
 	if !p.NodeStarted() {
 		return true
 	}
-	if osi.Eq(nsi) {
+	if osi.Eq(nsi) && osi.Caps == nsi.Caps {
 		nlog.Infof("%s: %s is already *in*", p, nsi.StringEx())
 		return false
 	}
@@ -11,6 +11,7 @@ import (
 	"reflect"
 	"sort"
 	"strconv"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -337,6 +338,12 @@ func (p *proxy) logsETL(w http.ResponseWriter, r *http.Request, etlName string,
 			p.writeErrf(w, r, "unknown target %q", tid)
 			return
 		}
+		// streaming (tail and/or follow): redirect to the target that runs the ETL pod
+		if q := r.URL.Query(); q.Has(apc.QparamLogTail) || q.Has(apc.QparamLogFollow) {
+			redirectURL := p.redirectURL(r, si, time.Now(), cmn.NetIntraControl)
+			http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+			return
+		}
 		results = make(sliceResults, 1)
 		cargs := allocCargs()
 		{
@@ -348,6 +355,10 @@ func (p *proxy) logsETL(w http.ResponseWriter, r *http.Request, etlName string,
 		results[0] = p.call(cargs, smap)
 		freeCargs(cargs)
 	} else {
+		if q := r.URL.Query(); q.Has(apc.QparamLogTail) || q.Has(apc.QparamLogFollow) {
+			p.writeErrf(w, r, "streaming ETL[%s] logs requires target ID", etlName)
+			return
+		}
 		// all targets
 		args = allocBcArgs()
 		args.req = cmn.HreqArgs{Method: http.MethodGet, Path: r.URL.Path}
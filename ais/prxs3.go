@@ -557,15 +557,27 @@ func (p *proxy) listMultipart(w http.ResponseWriter, r *http.Request, bck *meta.
 		p.s3Redirect(w, r, si, redirectURL, bck.Name)
 		return
 	}
-	// bcast & aggregate
-	all := &s3.ListMptUploadsResult{}
+	// bcast & aggregate (pagination and delimiter roll-up are done here, across all targets;
+	// targets only filter by prefix)
+	var (
+		all  = &s3.ListMptUploadsResult{Bucket: bck.Name}
+		args = s3.NewListMptUploadsArgs(q)
+		tq   = make(url.Values, len(q))
+	)
+	for k, v := range q {
+		switch k {
+		case s3.QparamMptUploadIDMarker, s3.QparamMptKeyMarker, s3.QparamMptMaxUploads, s3.QparamDelimiter:
+		default:
+			tq[k] = v
+		}
+	}
 	for _, si := range smap.Tmap {
 		var (
 			url   = si.URL(cmn.NetPublic)
 			cargs = allocCargs()
 		)
 		cargs.si = si
-		cargs.req = cmn.HreqArgs{Method: http.MethodGet, Base: url, Path: r.URL.Path, Query: q}
+		cargs.req = cmn.HreqArgs{Method: http.MethodGet, Base: url, Path: r.URL.Path, Query: tq}
 		res := p.call(cargs, smap)
 		b, err := res.bytes, res.err
 		freeCargs(cargs)
@@ -573,16 +585,11 @@ func (p *proxy) listMultipart(w http.ResponseWriter, r *http.Request, bck *meta.
 		if err == nil {
 			results := &s3.ListMptUploadsResult{}
 			if err := xml.Unmarshal(b, results); err == nil {
-				if len(results.Uploads) > 0 {
-					if len(all.Uploads) == 0 {
-						*all = *results
-						all.Uploads = make([]s3.UploadInfoResult, 0)
-					}
-					all.Uploads = append(all.Uploads, results.Uploads...)
-				}
+				all.Uploads = append(all.Uploads, results.Uploads...)
 			}
 		}
 	}
+	all.Paginate(args)
 	sgl := p.gmm.NewSGL(0)
 	all.MustMarshal(sgl)
 	w.Header().Set(cos.HdrContentType, cos.ContentXML)
@@ -572,7 +572,7 @@ func bmodMv(ctx *bmdModifier, clone *bucketMD) error {
 
 // transform (or simply copy) bucket to another bucket
 // { confirm existence -- begin -- conditional metasync -- start waiting for operation done -- commit }
-func (p *proxy) tcb(bckFrom, bckTo *meta.Bck, msg *apc.ActMsg, dryRun bool) (xid string, err error) {
+func (p *proxy) tcb(bckFrom, bckTo *meta.Bck, msg *apc.ActMsg, cpmsg *apc.CopyBckMsg) (xid string, err error) {
 	// 1. confirm existence
 	bmd := p.owner.bmd.get()
 	if _, existsFrom := bmd.Get(bckFrom); !existsFrom {
@@ -584,7 +584,7 @@ func (p *proxy) tcb(bckFrom, bckTo *meta.Bck, msg *apc.ActMsg, dryRun bool) (xid
 
 	// 2. begin
 	var (
-		waitmsync = !dryRun && !existsTo
+		waitmsync = !cpmsg.DryRun && !existsTo
 		c         = p.prepTxnClient(msg, bckFrom, waitmsync)
 	)
 	_ = bckTo.AddUnameToQuery(c.req.Query, apc.QparamBckTo)
@@ -593,14 +593,15 @@ func (p *proxy) tcb(bckFrom, bckTo *meta.Bck, msg *apc.ActMsg, dryRun bool) (xid
 	}
 
 	// 3. create dst bucket if doesn't exist - clone bckFrom props
-	if !dryRun && !existsTo {
+	if !cpmsg.DryRun && !existsTo {
 		ctx := &bmdModifier{
-			pre:   bmodCpProps,
-			final: p.bmodSync,
-			msg:   msg,
-			txnID: c.uuid,
-			bcks:  []*meta.Bck{bckFrom, bckTo},
-			wait:  waitmsync,
+			pre:        bmodCpProps,
+			final:      p.bmodSync,
+			msg:        msg,
+			txnID:      c.uuid,
+			bcks:       []*meta.Bck{bckFrom, bckTo},
+			wait:       waitmsync,
+			cloneProps: cpmsg.CloneProps,
 		}
 		bmd, err = p.owner.bmd.modify(ctx)
 		if err != nil {
@@ -662,12 +663,13 @@ func (p *proxy) tcobjs(bckFrom, bckTo *meta.Bck, config *cmn.Config, msg *apc.Ac
 	// 3. create dst bucket if doesn't exist - clone bckFrom props
 	if !tcomsg.TCBMsg.DryRun && !existsTo {
 		ctx := &bmdModifier{
-			pre:   bmodCpProps,
-			final: p.bmodSync,
-			msg:   msg,
-			txnID: c.uuid,
-			bcks:  []*meta.Bck{bckFrom, bckTo},
-			wait:  waitmsync,
+			pre:        bmodCpProps,
+			final:      p.bmodSync,
+			msg:        msg,
+			txnID:      c.uuid,
+			bcks:       []*meta.Bck{bckFrom, bckTo},
+			wait:       waitmsync,
+			cloneProps: tcomsg.TCBMsg.CloneProps,
 		}
 		bmd, err := p.owner.bmd.modify(ctx)
 		if err != nil {
@@ -1031,9 +1033,20 @@ func bmodCpProps(ctx *bmdModifier, clone *bucketMD) error {
 	debug.Assert(bckTo.IsAIS())
 	bckFrom.Props = bprops.Clone()
 	// replicate bucket props - but only if the source is ais as well
-	if bckFrom.IsAIS() || bckFrom.IsRemoteAIS() {
+	// (or, when explicitly requested, inherit remote source's data-management props)
+	switch {
+	case bckFrom.IsAIS() || bckFrom.IsRemoteAIS():
 		bckTo.Props = bprops.Clone()
-	} else {
+	case ctx.cloneProps:
+		bckTo.Props = defaultBckProps(bckPropsArgs{bck: bckTo})
+		bckTo.Props.Cksum = bprops.Cksum
+		bckTo.Props.Mirror = bprops.Mirror
+		bckTo.Props.EC = bprops.EC
+		bckTo.Props.LRU = bprops.LRU
+		bckTo.Props.WritePolicy = bprops.WritePolicy
+		bckTo.Props.Access = bprops.Access
+		bckTo.Props.Features = bprops.Features
+	default:
 		bckTo.Props = defaultBckProps(bckPropsArgs{bck: bckTo})
 	}
 	added := clone.add(bckTo, bckTo.Props)
@@ -0,0 +1,133 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Multipart uploads that were neither completed nor aborted (e.g., the client went away)
+// expire after `space.mpt_upload_ttl` of inactivity (the time since InitUpload or the most
+// recent AddPart). Expiration is driven by the in-memory upload state (which, in turn,
+// survives restarts - see journal.go) rather than by walking the workfile directories.
+
+const DfltUploadTTL = 24 * time.Hour
+
+type (
+	XactMptCleanup struct {
+		xact.Base
+	}
+	mptClnFactory struct {
+		xreg.RenewBase
+		xctn *XactMptCleanup
+	}
+)
+
+// interface guard
+var (
+	_ xreg.Renewable = (*mptClnFactory)(nil)
+	_ core.Xact      = (*XactMptCleanup)(nil)
+)
+
+func Xreg() { xreg.RegNonBckXact(&mptClnFactory{}) }
+
+func UploadTTL(config *cmn.Config) time.Duration {
+	if ttl := config.Space.MptUploadTTL.D(); ttl > 0 {
+		return ttl
+	}
+	return DfltUploadTTL
+}
+
+// IDs of the uploads that have been inactive for at least `ttl`
+func StaleUploads(ttl time.Duration) (ids []string) {
+	now := time.Now()
+	mu.RLock()
+	for id, mpt := range ups {
+		if now.Sub(mpt.mtime) >= ttl {
+			ids = append(ids, id)
+		}
+	}
+	mu.RUnlock()
+	return ids
+}
+
+// abort the upload (unless it's been active in the meantime) and remove its parts
+func ExpireUpload(id string, ttl time.Duration) (nparts int, size int64, ok bool) {
+	mu.Lock()
+	mpt, ok := ups[id]
+	if !ok || time.Since(mpt.mtime) < ttl {
+		mu.Unlock()
+		return 0, 0, false
+	}
+	delete(ups, id)
+	mu.Unlock()
+
+	mpt.rmJournal()
+	for _, part := range mpt.parts {
+		size += part.Size
+	}
+	mpt.rmParts()
+	return len(mpt.parts), size, true
+}
+
+func RunMptCleanup(xctn *XactMptCleanup, ttl time.Duration) {
+	for _, id := range StaleUploads(ttl) {
+		if xctn.IsAborted() {
+			break
+		}
+		nparts, size, ok := ExpireUpload(id, ttl)
+		if !ok {
+			continue
+		}
+		nlog.Infoln(xctn.Name(), "expired upload", id, "parts:", nparts)
+		xctn.ObjsAdd(nparts, size)
+	}
+	xctn.Finish()
+}
+
+////////////////////
+// XactMptCleanup //
+////////////////////
+
+func (*XactMptCleanup) Run(*sync.WaitGroup) { debug.Assert(false) }
+
+func (r *XactMptCleanup) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
+
+///////////////////
+// mptClnFactory //
+///////////////////
+
+func (*mptClnFactory) New(args xreg.Args, _ *meta.Bck) xreg.Renewable {
+	return &mptClnFactory{RenewBase: xreg.RenewBase{Args: args}}
+}
+
+func (p *mptClnFactory) Start() error {
+	p.xctn = &XactMptCleanup{}
+	p.xctn.InitBase(p.UUID(), apc.ActMptCleanup, nil)
+	return nil
+}
+
+func (*mptClnFactory) Kind() string     { return apc.ActMptCleanup }
+func (p *mptClnFactory) Get() core.Xact { return p.xctn }
+
+func (*mptClnFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (wpr xreg.WPR, err error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
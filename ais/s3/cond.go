@@ -0,0 +1,57 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Conditional GET (https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObject.html):
+// - "If-Match" takes precedence over "If-Unmodified-Since" (412 Precondition Failed);
+// - "If-None-Match" - over "If-Modified-Since" (304 Not Modified);
+// - the former pair is evaluated first.
+// Returns http.StatusOK when the request should proceed; otherwise, the status
+// along with the error (412) or nil (304).
+func CheckPreconditions(hdr http.Header, etag string, mtime time.Time) (int, error) {
+	mtime = mtime.Truncate(time.Second) // (HTTP-date granularity)
+	if v := hdr.Get(cos.HdrIfMatch); v != "" {
+		if !matchEtag(v, etag) {
+			return http.StatusPreconditionFailed, NewErrPreconditionFailed(cos.HdrIfMatch)
+		}
+	} else if v := hdr.Get(cos.HdrIfUnmodifiedSince); v != "" {
+		if since, err := http.ParseTime(v); err == nil && mtime.After(since) {
+			return http.StatusPreconditionFailed, NewErrPreconditionFailed(cos.HdrIfUnmodifiedSince)
+		}
+	}
+	if v := hdr.Get(cos.HdrIfNoneMatch); v != "" {
+		if matchEtag(v, etag) {
+			return http.StatusNotModified, nil
+		}
+	} else if v := hdr.Get(cos.HdrIfModifiedSince); v != "" {
+		if since, err := http.ParseTime(v); err == nil && !mtime.After(since) {
+			return http.StatusNotModified, nil
+		}
+	}
+	return http.StatusOK, nil
+}
+
+// comma-separated list of (quoted, possibly weak) entity tags, or "*"
+func matchEtag(list, etag string) bool {
+	for _, v := range strings.Split(list, ",") {
+		v = strings.TrimSpace(v)
+		if v == "*" {
+			return true
+		}
+		v = strings.Trim(strings.TrimPrefix(v, "W/"), "\"")
+		if v != "" && v == strings.Trim(etag, "\"") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func TestCheckPreconditions(t *testing.T) {
+	const etag = "0123456789abcdef"
+	var (
+		mtime  = time.Date(2024, 5, 1, 12, 0, 0, 500, time.UTC)
+		before = mtime.Add(-time.Hour).Format(http.TimeFormat)
+		same   = mtime.Format(http.TimeFormat)
+		after  = mtime.Add(time.Hour).Format(http.TimeFormat)
+	)
+	tests := []struct {
+		name   string
+		hdrs   map[string]string
+		status int
+	}{
+		{"none", nil, http.StatusOK},
+		{"if-match", map[string]string{cos.HdrIfMatch: `"` + etag + `"`}, http.StatusOK},
+		{"if-match-list", map[string]string{cos.HdrIfMatch: `"other", W/"` + etag + `"`}, http.StatusOK},
+		{"if-match-any", map[string]string{cos.HdrIfMatch: "*"}, http.StatusOK},
+		{"if-match-fail", map[string]string{cos.HdrIfMatch: `"other"`}, http.StatusPreconditionFailed},
+		{"if-none-match", map[string]string{cos.HdrIfNoneMatch: `"other"`}, http.StatusOK},
+		{"if-none-match-fail", map[string]string{cos.HdrIfNoneMatch: `"` + etag + `"`}, http.StatusNotModified},
+		{"if-modified-since", map[string]string{cos.HdrIfModifiedSince: before}, http.StatusOK},
+		{"if-modified-since-fail", map[string]string{cos.HdrIfModifiedSince: same}, http.StatusNotModified},
+		{"if-unmodified-since", map[string]string{cos.HdrIfUnmodifiedSince: same}, http.StatusOK},
+		{"if-unmodified-since-fail", map[string]string{cos.HdrIfUnmodifiedSince: before}, http.StatusPreconditionFailed},
+		{"if-unmodified-since-invalid", map[string]string{cos.HdrIfUnmodifiedSince: "yesterday"}, http.StatusOK},
+
+		// precedence
+		{"if-match-over-unmodified", map[string]string{cos.HdrIfMatch: etag, cos.HdrIfUnmodifiedSince: before},
+			http.StatusOK},
+		{"if-none-match-over-modified", map[string]string{cos.HdrIfNoneMatch: `"other"`, cos.HdrIfModifiedSince: after},
+			http.StatusOK},
+		{"412-over-304", map[string]string{cos.HdrIfMatch: `"other"`, cos.HdrIfNoneMatch: etag},
+			http.StatusPreconditionFailed},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hdr := make(http.Header)
+			for k, v := range test.hdrs {
+				hdr.Set(k, v)
+			}
+			status, err := CheckPreconditions(hdr, etag, mtime)
+			if status != test.status {
+				t.Fatalf("expected %d, got %d (err: %v)", test.status, status, err)
+			}
+			if (status == http.StatusPreconditionFailed) != (err != nil) {
+				t.Fatalf("status %d vs err %v", status, err)
+			}
+		})
+	}
+}
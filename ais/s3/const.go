@@ -4,7 +4,11 @@
  */
 package s3
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
 
 const (
 	// AWS URL params
@@ -26,6 +30,9 @@ const (
 	QparamMptPartNo         = "partNumber"
 	QparamMptMaxUploads     = "max-uploads"
 	QparamMptUploadIDMarker = "upload-id-marker"
+	QparamMptKeyMarker      = "key-marker"
+	QparamMptMaxParts       = "max-parts"
+	QparamMptPartNoMarker   = "part-number-marker"
 
 	QparamAccessKeyID = "AWSAccessKeyId"
 	QparamExpires     = "Expires"
@@ -46,6 +53,13 @@ const (
 	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/qfacts.html
 	MaxPartsPerUpload = 10000
 
+	// ListParts: default (and maximum) number of parts per page
+	MaxPartsPerList = 1000
+
+	// Part size limits: all parts but the last must be at least MinPartSize
+	MinPartSize = 5 * cos.MiB
+	MaxPartSize = 5 * cos.GiB
+
 	s3Namespace = "http://s3.amazonaws.com/doc/2006-03-01"
 	s3URL       = "https://%s.s3.%s.amazonaws.com/%s?%s"
 
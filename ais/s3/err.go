@@ -20,11 +20,121 @@ import (
 
 const ErrPrefix = "aws-error"
 
-type Error struct {
-	Code      string
-	Message   string
-	Resource  string
-	RequestID string `xml:"RequestId"`
+type (
+	Error struct {
+		Code      string
+		Message   string
+		Resource  string
+		RequestID string `xml:"RequestId"`
+	}
+	// "Content-MD5" (or "x-amz-checksum-*") mismatch
+	ErrBadDigest struct {
+		hdr, expected, actual string
+	}
+	// part size out of [MinPartSize, MaxPartSize] range
+	ErrEntityTooSmall struct {
+		size int64
+		num  int32
+	}
+	ErrEntityTooLarge struct {
+		size int64
+		num  int32
+	}
+	// CompleteMultipartUpload: part not found or ETag mismatch
+	ErrInvalidPart struct {
+		etag, md5 string
+		num       int32
+	}
+	// CompleteMultipartUpload: part numbers must be listed in strictly ascending order
+	ErrInvalidPartOrder struct {
+		num, prev int32
+	}
+	// conditional GET: "If-Match" or "If-Unmodified-Since" evaluates to false
+	ErrPreconditionFailed struct {
+		hdr string
+	}
+)
+
+func NewErrBadDigest(hdr, expected, actual string) *ErrBadDigest {
+	return &ErrBadDigest{hdr: hdr, expected: expected, actual: actual}
+}
+
+func (e *ErrBadDigest) Error() string {
+	return fmt.Sprintf("the %s you specified did not match what we received: expected %s, got %s",
+		e.hdr, e.expected, e.actual)
+}
+
+func IsErrBadDigest(err error) bool {
+	_, ok := err.(*ErrBadDigest)
+	return ok
+}
+
+func NewErrEntityTooSmall(num int32, size int64) *ErrEntityTooSmall {
+	return &ErrEntityTooSmall{size: size, num: num}
+}
+
+func (e *ErrEntityTooSmall) Error() string {
+	return fmt.Sprintf("part %d is too small: %s (minimum allowed size: %s, except the last part)",
+		e.num, cos.ToSizeIEC(e.size, 2), cos.ToSizeIEC(MinPartSize, 0))
+}
+
+func NewErrEntityTooLarge(num int32, size int64) *ErrEntityTooLarge {
+	return &ErrEntityTooLarge{size: size, num: num}
+}
+
+func (e *ErrEntityTooLarge) Error() string {
+	return fmt.Sprintf("part %d is too large: %s (maximum allowed size: %s)",
+		e.num, cos.ToSizeIEC(e.size, 2), cos.ToSizeIEC(MaxPartSize, 0))
+}
+
+func NewErrInvalidPart(num int32, etag, md5 string) *ErrInvalidPart {
+	return &ErrInvalidPart{num: num, etag: etag, md5: md5}
+}
+
+func (e *ErrInvalidPart) Error() string {
+	if e.md5 == "" {
+		return fmt.Sprintf("part %d not found", e.num)
+	}
+	return fmt.Sprintf("part %d: specified ETag %q does not match the uploaded part's %q", e.num, e.etag, e.md5)
+}
+
+func NewErrInvalidPartOrder(num, prev int32) *ErrInvalidPartOrder {
+	return &ErrInvalidPartOrder{num: num, prev: prev}
+}
+
+func (e *ErrInvalidPartOrder) Error() string {
+	if e.num == e.prev {
+		return fmt.Sprintf("duplicate part number %d", e.num)
+	}
+	return fmt.Sprintf("part numbers must be in ascending order: %d follows %d", e.num, e.prev)
+}
+
+func NewErrPreconditionFailed(hdr string) *ErrPreconditionFailed {
+	return &ErrPreconditionFailed{hdr: hdr}
+}
+
+func (e *ErrPreconditionFailed) Error() string {
+	return "at least one of the preconditions you specified did not hold: " + e.hdr
+}
+
+// S3 error code for the errors defined in this package (empty string otherwise)
+func errCode(err error) string {
+	switch err.(type) {
+	case *ErrBadDigest:
+		return "BadDigest"
+	case *ErrEntityTooSmall:
+		return "EntityTooSmall"
+	case *ErrEntityTooLarge:
+		return "EntityTooLarge"
+	case *ErrInvalidPart:
+		return "InvalidPart"
+	case *ErrInvalidPartOrder:
+		return "InvalidPartOrder"
+	case *ErrPreconditionFailed:
+		return "PreconditionFailed"
+	default:
+		return ""
+	}
 }
 
 func (e *Error) mustMarshal(sgl *memsys.SGL) {
@@ -35,6 +145,10 @@ func (e *Error) mustMarshal(sgl *memsys.SGL) {
 
 // with user-friendly tip
 func WriteMptErr(w http.ResponseWriter, r *http.Request, err error, ecode int, lom *core.LOM, uploadID string) {
+	if errCode(err) != "" {
+		WriteErr(w, r, err, http.StatusBadRequest) // client error; the upload remains valid and can be retried
+		return
+	}
 	// specifically, for s3cmd example
 	name := strings.Replace(lom.Cname(), apc.AISScheme+apc.BckProviderSeparator, apc.S3Scheme+apc.BckProviderSeparator, 1)
 	s3cmd := "s3cmd abortmp " + name + " " + uploadID
@@ -65,6 +179,8 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, ecode int) {
 		out.Code = "BucketAlreadyExists"
 	case cmn.IsErrBckNotFound(err):
 		out.Code = "NoSuchBucket"
+	case errCode(err) != "":
+		out.Code = errCode(err)
 	case in.TypeCode != "":
 		out.Code = in.TypeCode
 	default:
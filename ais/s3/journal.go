@@ -0,0 +1,162 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Multipart upload journal: a small JSON file (workfile) that gets rewritten upon
+// InitUpload and every AddPart, and removed upon CleanupUpload.
+// Upon target restart, LoadActiveUploads rehydrates the uploads that were in progress.
+//
+// Workfiles are named after the process that creates them (see fs.WorkfileContentResolver),
+// and those of a previous process are considered abandoned (see fs.RmOldWorkfiles and space cleanup).
+// That's why the loader renames both the journal and its parts - to make them "ours".
+// Parts that are not referenced by any journal remain "old" and get garbage-collected
+// after `space.workfile_ttl`.
+
+const (
+	journalPrefix = "mpt-journal"
+	journalVer    = 1
+)
+
+type journal struct {
+	Bck     cmn.Bck    `json:"bck"`
+	ObjName string     `json:"obj"`
+	ID      string     `json:"id"`
+	Parts   []*MptPart `json:"parts"`
+	Ctime   int64      `json:"ctime"`
+	Mtime   int64      `json:"mtime"`
+	CkType  string     `json:"cksum_type,omitempty"`
+}
+
+// (re)write the journal; errors are logged but not returned -
+// the upload itself is not affected
+func (mpt *mpt) journal(id string) {
+	mpt.jmu.Lock()
+	mu.RLock()
+	j := &journal{
+		Bck:     mpt.bck,
+		ObjName: mpt.objName,
+		ID:      id,
+		Parts:   append([]*MptPart(nil), mpt.parts...),
+		Ctime:   mpt.ctime.UnixNano(),
+		Mtime:   mpt.mtime.UnixNano(),
+		CkType:  mpt.ckty,
+	}
+	mu.RUnlock()
+	if err := jsp.Save(mpt.jfqn, j, jsp.CksumSign(journalVer), nil); err != nil {
+		nlog.Errorln("failed to journal upload", id, "err:", err)
+	}
+	mpt.jmu.Unlock()
+}
+
+// Rehydrate multipart uploads that were active when the target was last stopped.
+// Must be called upon startup prior to serving S3 requests (and prior to fs.RmOldWorkfiles).
+// Skips parts whose workfiles no longer exist. Returns the number of loaded uploads.
+func LoadActiveUploads() (n int) {
+	var (
+		bmd  = core.T.Bowner().Get()
+		bcks = make([]cmn.Bck, 0, 16)
+	)
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		bcks = append(bcks, *bck.Bucket())
+		return false
+	})
+	for _, mi := range fs.GetAvail() {
+		for i := range bcks {
+			opts := &fs.WalkOpts{
+				Mi:  mi,
+				Bck: bcks[i],
+				CTs: []string{fs.WorkfileType},
+				Callback: func(fqn string, de fs.DirEntry) error {
+					if !de.IsDir() && loadJournal(fqn) {
+						n++
+					}
+					return nil
+				},
+			}
+			if err := fs.Walk(opts); err != nil {
+				nlog.Warningln(mi.String()+":", "failed to load multipart uploads of", bcks[i].Cname(""), "err:", err)
+			}
+		}
+	}
+	if n > 0 {
+		nlog.Infoln("loaded", n, "active multipart upload(s)")
+	}
+	return n
+}
+
+func loadJournal(fqn string) bool {
+	base := filepath.Base(fqn)
+	if !strings.HasPrefix(base, journalPrefix+".") {
+		return false
+	}
+	if _, old, ok := fs.CSM.Resolver(fs.WorkfileType).ParseUniqueFQN(base); !ok || !old {
+		return false // (e.g., the one we've just written)
+	}
+	j := &journal{}
+	if _, err := jsp.Load(fqn, j, jsp.CksumSign(journalVer)); err != nil {
+		nlog.Errorln("failed to load multipart upload journal", fqn, "err:", err)
+		return false
+	}
+	lom := &core.LOM{ObjName: j.ObjName}
+	if err := lom.InitBck(&j.Bck); err != nil {
+		nlog.Warningln("upload", j.ID, "err:", err)
+		return false
+	}
+	mpt := &mpt{
+		bck:     j.Bck,
+		objName: j.ObjName,
+		jfqn:    fs.CSM.Gen(lom, fs.WorkfileType, journalPrefix+"."+j.ID),
+		parts:   make([]*MptPart, 0, max(len(j.Parts), iniCapParts)),
+		ctime:   time.Unix(0, j.Ctime),
+		mtime:   time.Unix(0, j.Mtime),
+		ckty:    j.CkType,
+	}
+	if j.Mtime == 0 {
+		mpt.mtime = mpt.ctime
+	}
+	for _, part := range j.Parts {
+		if err := cos.Stat(part.FQN); err != nil {
+			nlog.Warningln("upload", j.ID, "part", part.Num, "is missing:", err)
+			continue
+		}
+		// take ownership (see above)
+		wfqn := fs.CSM.Gen(lom, fs.WorkfileType, j.ID+"."+strconv.FormatInt(int64(part.Num), 10))
+		if err := os.Rename(part.FQN, wfqn); err != nil {
+			nlog.Warningln("upload", j.ID, "part", part.Num, "err:", err)
+			continue
+		}
+		part.FQN = wfqn
+		mpt.parts = append(mpt.parts, part)
+	}
+
+	mu.Lock()
+	if ups == nil {
+		ups = make(uploads, 8)
+	}
+	ups[j.ID] = mpt
+	mu.Unlock()
+
+	mpt.journal(j.ID)
+	if err := cos.RemoveFile(fqn); err != nil {
+		nlog.Errorln(err)
+	}
+	return true
+}
@@ -5,17 +5,22 @@
 package s3
 
 import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"os"
-	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
-	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/fs"
 )
 
 // NOTE: xattr stores only the (*) marked attributes
@@ -27,10 +32,14 @@ type (
 		Num  int32  // part number (*)
 	}
 	mpt struct {
-		bckName string
+		bck     cmn.Bck
 		objName string
+		jfqn    string     // journal (see journal.go)
 		parts   []*MptPart // by part number
 		ctime   time.Time  // InitUpload time
+		mtime   time.Time  // last activity (InitUpload or AddPart) - see cleanup.go
+		ckty    string     // part checksum type negotiated via "x-amz-checksum-algorithm" (empty: MD5 only)
+		jmu     sync.Mutex // serializes journal updates
 	}
 	uploads map[string]*mpt // by upload ID
 )
@@ -40,37 +49,64 @@ var (
 	mu  sync.RWMutex
 )
 
-// Start miltipart upload
-func InitUpload(id, bckName, objName string) {
+// Start miltipart upload; `ckty` is the additional part checksum type (see ParseCksumAlgo)
+func InitUpload(id string, lom *core.LOM, ckty string) {
+	now := time.Now()
+	mpt := &mpt{
+		bck:     *lom.Bucket(),
+		objName: lom.ObjName,
+		jfqn:    fs.CSM.Gen(lom, fs.WorkfileType, journalPrefix+"."+id),
+		parts:   make([]*MptPart, 0, iniCapParts),
+		ctime:   now,
+		mtime:   now,
+		ckty:    ckty,
+	}
 	mu.Lock()
 	if ups == nil {
 		ups = make(uploads, 8)
 	}
-	ups[id] = &mpt{
-		bckName: bckName,
-		objName: objName,
-		parts:   make([]*MptPart, 0, iniCapParts),
-		ctime:   time.Now(),
-	}
+	ups[id] = mpt
 	mu.Unlock()
+	mpt.journal(id)
+}
+
+// part checksum type of the upload (empty if not negotiated or upload not found)
+func CksumType(id string) (ckty string) {
+	mu.RLock()
+	if mpt, ok := ups[id]; ok {
+		ckty = mpt.ckty
+	}
+	mu.RUnlock()
+	return ckty
 }
 
 // Add part to an active upload.
 // Some clients may omit size and md5. Only partNum is must-have.
 // md5 and fqn is filled by a target after successful saving the data to a workfile.
+// Re-uploading the same part number (legal in S3) replaces the part.
 func AddPart(id string, npart *MptPart) (err error) {
 	mu.Lock()
 	mpt, ok := ups[id]
 	if !ok {
 		err = fmt.Errorf("upload %q not found (%s, %d)", id, npart.FQN, npart.Num)
 	} else {
-		mpt.parts = append(mpt.parts, npart)
+		if i := mpt.partIdx(npart.Num); i >= 0 {
+			mpt.parts[i] = npart
+		} else {
+			mpt.parts = append(mpt.parts, npart)
+		}
+		mpt.mtime = time.Now()
 	}
 	mu.Unlock()
+	if ok {
+		mpt.journal(id)
+	}
 	return
 }
 
-// TODO: compare non-zero sizes (note: s3cmd sends 0) and part.ETag as well, if specified
+// Check that all parts are listed in ascending order, present, and match the specified ETags (if any);
+// enforce part size limits (every part except the last one must be at least MinPartSize).
+// TODO: compare non-zero sizes (note: s3cmd sends 0)
 func CheckParts(id string, parts []*PartInfo) ([]*MptPart, error) {
 	mu.RLock()
 	defer mu.RUnlock()
@@ -78,19 +114,32 @@ func CheckParts(id string, parts []*PartInfo) ([]*MptPart, error) {
 	if !ok {
 		return nil, fmt.Errorf("upload %q not found", id)
 	}
-	// first, check that all parts are present
+	// first, check order, presence, and ETags
 	var prev = int32(-1)
 	for _, part := range parts {
-		debug.Assert(part.PartNumber > prev) // must ascend
-		if mpt.getPart(part.PartNumber) == nil {
-			return nil, fmt.Errorf("upload %q: part %d not found", id, part.PartNumber)
+		if part.PartNumber <= prev {
+			return nil, NewErrInvalidPartOrder(part.PartNumber, prev)
+		}
+		npart := mpt.getPart(part.PartNumber)
+		if npart == nil {
+			return nil, NewErrInvalidPart(part.PartNumber, part.ETag, "")
+		}
+		if etag := cmn.UnquoteCEV(part.ETag); etag != "" && npart.MD5 != "" && etag != npart.MD5 {
+			return nil, NewErrInvalidPart(part.PartNumber, etag, npart.MD5)
 		}
 		prev = part.PartNumber
 	}
 	// copy (to work on it with no locks)
 	nparts := make([]*MptPart, 0, len(parts))
-	for _, part := range parts {
-		nparts = append(nparts, mpt.getPart(part.PartNumber))
+	for i, part := range parts {
+		npart := mpt.getPart(part.PartNumber)
+		switch {
+		case npart.Size > MaxPartSize:
+			return nil, NewErrEntityTooLarge(npart.Num, npart.Size)
+		case npart.Size < MinPartSize && i < len(parts)-1:
+			return nil, NewErrEntityTooSmall(npart.Num, npart.Size)
+		}
+		nparts = append(nparts, npart)
 	}
 	return nparts, nil
 }
@@ -103,20 +152,80 @@ func ParsePartNum(s string) (int32, error) {
 	return int32(partNum), err
 }
 
-// Return a sum of upload part sizes.
-// Used on upload completion to calculate the final size of the object.
-func ObjSize(id string) (size int64, err error) {
-	mu.RLock()
-	mpt, ok := ups[id]
-	if !ok {
-		err = fmt.Errorf("upload %q not found", id)
-	} else {
-		for _, part := range mpt.parts {
-			size += part.Size
+// "Content-MD5" header carries base64-encoded 128-bit digest;
+// return the latter as a hex string (to compare with cos.ChecksumMD5 values)
+func DecodeContentMD5(hdr string) (string, error) {
+	b, err := base64.StdEncoding.DecodeString(hdr)
+	if err != nil || len(b) != md5.Size {
+		return "", fmt.Errorf("invalid %s %q", cos.S3HdrContentMD5, hdr)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// validate the computed MD5 (hex) against "Content-MD5" (hex-decoded), if specified
+func CheckContentMD5(expected, computed string) error {
+	if expected == "" || expected == computed {
+		return nil
+	}
+	return NewErrBadDigest(cos.S3HdrContentMD5, expected, computed)
+}
+
+// "x-amz-checksum-algorithm" => checksum type; supported algorithms:
+var cksumAlgos = [...]struct {
+	algo, ty, hdr string
+}{
+	{"SHA256", cos.ChecksumSHA256, cos.S3ChecksumSHA256},
+	{"CRC32C", cos.ChecksumCRC32C, cos.S3ChecksumCRC32C},
+}
+
+// (empty algorithm => empty type, i.e., MD5 only)
+func ParseCksumAlgo(algo string) (string, error) {
+	if algo == "" {
+		return "", nil
+	}
+	for _, a := range cksumAlgos {
+		if strings.EqualFold(algo, a.algo) {
+			return a.ty, nil
 		}
 	}
-	mu.RUnlock()
-	return
+	return "", fmt.Errorf("unsupported %s %q (expecting %s or %s)", cos.S3HdrCksumAlgo, algo,
+		cksumAlgos[0].algo, cksumAlgos[1].algo)
+}
+
+// checksum type => algorithm name and "x-amz-checksum-*" header
+func CksumAlgo(ckty string) (algo, hdr string) {
+	for _, a := range cksumAlgos {
+		if a.ty == ckty {
+			return a.algo, a.hdr
+		}
+	}
+	return "", ""
+}
+
+// validate "x-amz-checksum-*" part header (if any) against the upload's checksum type;
+// return the expected (base64-encoded) value
+func PartCksumHdr(hdr http.Header, ckty string) (string, error) {
+	_, expected := CksumAlgo(ckty)
+	for _, h := range []string{cos.S3ChecksumCRC32, cos.S3ChecksumCRC32C, cos.S3ChecksumSHA1, cos.S3ChecksumSHA256} {
+		if v := hdr.Get(h); v != "" && h != expected {
+			return "", fmt.Errorf("%s does not match the upload's %s %q", h, cos.S3HdrCksumAlgo, ckty)
+		}
+	}
+	if expected == "" {
+		return "", nil
+	}
+	return hdr.Get(expected), nil
+}
+
+// validate computed checksum against the specified (base64) one, if any;
+// return the former (base64)
+func CheckPartCksum(ck *cos.CksumHash, expected string) (string, error) {
+	computed := base64.StdEncoding.EncodeToString(ck.Sum())
+	if expected == "" || expected == computed {
+		return computed, nil
+	}
+	_, hdr := CksumAlgo(ck.Type())
+	return "", NewErrBadDigest(hdr, expected, computed)
 }
 
 // remove all temp files and delete from the map
@@ -132,47 +241,44 @@ func CleanupUpload(id, fqn string, aborted bool) (exists bool) {
 	delete(ups, id)
 	mu.Unlock()
 
+	mpt.rmJournal()
 	if !aborted {
 		if err := storeMptXattr(fqn, mpt); err != nil {
 			nlog.Warningf("fqn %s, id %s: %v", fqn, id, err)
 		}
 	}
+	mpt.rmParts()
+	return true
+}
+
+func (mpt *mpt) rmJournal() {
+	mpt.jmu.Lock()
+	if err := os.Remove(mpt.jfqn); err != nil && !os.IsNotExist(err) {
+		nlog.Errorln(err)
+	}
+	mpt.jmu.Unlock()
+}
+
+func (mpt *mpt) rmParts() {
 	for _, part := range mpt.parts {
 		if err := os.Remove(part.FQN); err != nil && !os.IsNotExist(err) {
 			nlog.Errorln(err)
 		}
 	}
-	return true
 }
 
-func ListUploads(bckName, idMarker string, maxUploads int) (result *ListMptUploadsResult) {
+func ListUploads(bckName string, args *ListMptUploadsArgs) (result *ListMptUploadsResult) {
 	mu.RLock()
 	results := make([]UploadInfoResult, 0, len(ups))
 	for id, mpt := range ups {
-		results = append(results, UploadInfoResult{Key: mpt.objName, UploadID: id, Initiated: mpt.ctime})
+		if mpt.bck.Name == bckName && strings.HasPrefix(mpt.objName, args.Prefix) {
+			results = append(results, UploadInfoResult{Key: mpt.objName, UploadID: id, Initiated: mpt.ctime})
+		}
 	}
 	mu.RUnlock()
 
-	sort.Slice(results, func(i int, j int) bool {
-		return results[i].Initiated.Before(results[j].Initiated)
-	})
-
-	var from int
-	if idMarker != "" {
-		// truncate
-		for i, res := range results {
-			if res.UploadID == idMarker {
-				from = i + 1
-				break
-			}
-			copy(results, results[from:])
-			results = results[:len(results)-from]
-		}
-	}
-	if maxUploads > 0 && len(results) > maxUploads {
-		results = results[:maxUploads]
-	}
-	result = &ListMptUploadsResult{Bucket: bckName, Uploads: results, IsTruncated: from > 0}
+	result = &ListMptUploadsResult{Bucket: bckName, Uploads: results}
+	result.Paginate(args)
 	return
 }
 
@@ -186,7 +292,7 @@ func ListParts(id string, lom *core.LOM) (parts []*PartInfo, ecode int, err erro
 			mu.RUnlock()
 			return nil, ecode, err
 		}
-		mpt.bckName, mpt.objName = lom.Bck().Name, lom.ObjName
+		mpt.bck, mpt.objName = *lom.Bucket(), lom.ObjName
 		mpt.ctime = lom.Atime()
 	}
 	parts = make([]*PartInfo, 0, len(mpt.parts))
@@ -0,0 +1,470 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+func TestListUploadsPaginate(t *testing.T) {
+	const num = 7
+	var (
+		now = time.Now()
+		all = &ListMptUploadsResult{}
+	)
+	for i := num - 1; i >= 0; i-- {
+		all.Uploads = append(all.Uploads, UploadInfoResult{
+			Key:       "obj-" + strconv.Itoa(i),
+			UploadID:  "id-" + strconv.Itoa(i),
+			Initiated: now.Add(time.Duration(i) * time.Second),
+		})
+	}
+
+	// follow the markers page by page
+	var (
+		marker string
+		seen   []string
+	)
+	for range num {
+		page := &ListMptUploadsResult{Uploads: append([]UploadInfoResult(nil), all.Uploads...)}
+		page.Paginate(&ListMptUploadsArgs{UploadIDMarker: marker, MaxUploads: 3})
+		for _, u := range page.Uploads {
+			seen = append(seen, u.UploadID)
+		}
+		if !page.IsTruncated {
+			break
+		}
+		marker = page.NextUploadIDMarker
+	}
+	if len(seen) != num {
+		t.Fatalf("expected %d uploads, got %d: %v", num, len(seen), seen)
+	}
+	for i, id := range seen {
+		if id != "id-"+strconv.Itoa(i) {
+			t.Fatalf("expected uploads ordered by initiation time, got %v", seen)
+		}
+	}
+}
+
+func TestContentMD5(t *testing.T) {
+	var (
+		data     = []byte("multipart upload part")
+		sum      = md5.Sum(data)
+		computed = hex.EncodeToString(sum[:])
+		hdr      = base64.StdEncoding.EncodeToString(sum[:])
+	)
+	// valid
+	expected, err := DecodeContentMD5(hdr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckContentMD5(expected, computed); err != nil {
+		t.Fatalf("expected match, got %v", err)
+	}
+
+	// mismatch
+	other := md5.Sum([]byte("something else"))
+	expected, err = DecodeContentMD5(base64.StdEncoding.EncodeToString(other[:]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = CheckContentMD5(expected, computed)
+	if !IsErrBadDigest(err) {
+		t.Fatalf("expected BadDigest, got %v", err)
+	}
+	w, r := httptest.NewRecorder(), httptest.NewRequest(http.MethodPut, "/s3/bck/obj", http.NoBody)
+	WriteErr(w, r, err, http.StatusBadRequest)
+	if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "<Code>BadDigest</Code>") {
+		t.Fatalf("expected %d BadDigest, got %d %q", http.StatusBadRequest, w.Code, w.Body.String())
+	}
+
+	// missing header
+	if err := CheckContentMD5("", computed); err != nil {
+		t.Fatalf("expected no error when Content-MD5 is not specified, got %v", err)
+	}
+
+	// not base64 or wrong length
+	for _, bad := range []string{"not-base64!", base64.StdEncoding.EncodeToString([]byte("short"))} {
+		if _, err := DecodeContentMD5(bad); err == nil {
+			t.Fatalf("expected %q to fail", bad)
+		}
+	}
+}
+
+// single mountpath, single ais bucket
+func newTestLOM(t *testing.T, objName string) *core.LOM {
+	bck := meta.NewBck("bck", apc.AIS, cmn.NsGlobal, &cmn.Bprops{BID: 1})
+	fs.TestNew(nil)
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+	mpath := t.TempDir()
+	if _, err := fs.Add(mpath, "daeID"); err != nil {
+		t.Fatal(err)
+	}
+	_ = mock.NewTarget(mock.NewBaseBownerMock(bck))
+
+	lom := &core.LOM{ObjName: objName}
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		t.Fatal(err)
+	}
+	if err := cos.CreateDir(lom.Mountpath().MakePathCT(lom.Bucket(), fs.WorkfileType)); err != nil {
+		t.Fatal(err)
+	}
+	return lom
+}
+
+func TestCheckPartsSize(t *testing.T) {
+	const id = "test-upload-part-size"
+	InitUpload(id, newTestLOM(t, "obj"), "")
+	defer CleanupUpload(id, "", true /*aborted*/)
+
+	if err := AddPart(id, &MptPart{Num: 1, Size: MinPartSize - 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := AddPart(id, &MptPart{Num: 2, Size: cos.KiB}); err != nil {
+		t.Fatal(err)
+	}
+
+	// first part is undersized
+	_, err := CheckParts(id, []*PartInfo{{PartNumber: 1}, {PartNumber: 2}})
+	if _, ok := err.(*ErrEntityTooSmall); !ok {
+		t.Fatalf("expected EntityTooSmall, got %v", err)
+	}
+	if s := err.Error(); !strings.Contains(s, "part 1") {
+		t.Fatalf("expected error to name the offending part: %q", s)
+	}
+	w, r := httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/s3/bck/obj", http.NoBody)
+	WriteMptErr(w, r, err, 0, nil, id)
+	if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "<Code>EntityTooSmall</Code>") {
+		t.Fatalf("expected %d EntityTooSmall, got %d %q", http.StatusBadRequest, w.Code, w.Body.String())
+	}
+
+	// the last part can be of any size
+	if _, err := CheckParts(id, []*PartInfo{{PartNumber: 2}}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadActiveUploads(t *testing.T) {
+	const id = "test-upload-journal"
+	var (
+		lom   = newTestLOM(t, "dir/obj")
+		wfqns = make([]string, 0, 2)
+	)
+	InitUpload(id, lom, "")
+	for num := int32(1); num <= 2; num++ {
+		wfqn := fs.CSM.Gen(lom, fs.WorkfileType, id+"."+strconv.Itoa(int(num)))
+		if err := os.WriteFile(wfqn, []byte("part"), cos.PermRWR); err != nil {
+			t.Fatal(err)
+		}
+		if err := AddPart(id, &MptPart{Num: num, Size: 4, MD5: "md5", FQN: wfqn}); err != nil {
+			t.Fatal(err)
+		}
+		wfqns = append(wfqns, wfqn)
+	}
+
+	// simulate restart: forget the upload, lose one of the parts,
+	// and make the journal look like it was written by a previous process
+	mu.Lock()
+	jfqn := ups[id].jfqn
+	delete(ups, id)
+	mu.Unlock()
+	if err := os.Remove(wfqns[1]); err != nil {
+		t.Fatal(err)
+	}
+	prev := jfqn[:strings.LastIndexByte(jfqn, '.')] + ".1" // (pid)
+	if err := os.Rename(jfqn, prev); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := LoadActiveUploads(); n != 1 {
+		t.Fatalf("expected to load 1 upload, got %d", n)
+	}
+	defer CleanupUpload(id, "", true /*aborted*/)
+
+	if res := ListUploads("bck", &ListMptUploadsArgs{}); len(res.Uploads) != 1 || res.Uploads[0].UploadID != id {
+		t.Fatalf("expected upload %q, got %+v", id, res.Uploads)
+	}
+	parts, _, err := ListParts(id, lom)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parts) != 1 || parts[0].PartNumber != 1 || parts[0].Size != 4 {
+		t.Fatalf("expected part #1 only, got %d part(s)", len(parts))
+	}
+
+	// the journal and the remaining part are now owned by the current process
+	mu.RLock()
+	mpt := ups[id]
+	mu.RUnlock()
+	wk := fs.CSM.Resolver(fs.WorkfileType)
+	for _, fqn := range []string{mpt.jfqn, mpt.parts[0].FQN} {
+		if err := cos.Stat(fqn); err != nil {
+			t.Fatal(err)
+		}
+		if _, old, ok := wk.ParseUniqueFQN(filepath.Base(fqn)); !ok || old {
+			t.Fatalf("%q: expected workfile of the current process", fqn)
+		}
+	}
+	if cos.Stat(prev) == nil || cos.Stat(wfqns[0]) == nil {
+		t.Fatal("expected the previous journal and part to be renamed")
+	}
+}
+
+func TestExpireUploads(t *testing.T) {
+	const (
+		stale  = "test-upload-stale"
+		active = "test-upload-active"
+		ttl    = time.Hour
+	)
+	lom := newTestLOM(t, "obj-expire")
+	InitUpload(stale, lom, "")
+	InitUpload(active, lom, "")
+	defer CleanupUpload(active, "", true /*aborted*/)
+
+	wfqn := fs.CSM.Gen(lom, fs.WorkfileType, stale+".1")
+	if err := os.WriteFile(wfqn, []byte("part"), cos.PermRWR); err != nil {
+		t.Fatal(err)
+	}
+	if err := AddPart(stale, &MptPart{Num: 1, Size: 4, FQN: wfqn}); err != nil {
+		t.Fatal(err)
+	}
+	mu.Lock()
+	ups[stale].mtime = time.Now().Add(-ttl)
+	jfqn := ups[stale].jfqn
+	mu.Unlock()
+
+	ids := StaleUploads(ttl)
+	if len(ids) != 1 || ids[0] != stale {
+		t.Fatalf("expected %q to be stale, got %v", stale, ids)
+	}
+	if _, _, ok := ExpireUpload(active, ttl); ok {
+		t.Fatalf("upload %q is active and must not expire", active)
+	}
+	nparts, size, ok := ExpireUpload(stale, ttl)
+	if !ok || nparts != 1 || size != 4 {
+		t.Fatalf("expected to expire 1 part (4 bytes), got %d (%d), %t", nparts, size, ok)
+	}
+	if cos.Stat(wfqn) == nil || cos.Stat(jfqn) == nil {
+		t.Fatal("expected the part and the journal to be removed")
+	}
+	if err := AddPart(stale, &MptPart{Num: 2}); err == nil {
+		t.Fatalf("expected upload %q to be gone", stale)
+	}
+}
+
+func TestListPartsPaginate(t *testing.T) {
+	const num = 2500
+	all := make([]*PartInfo, 0, num)
+	for i := num; i > 0; i-- {
+		all = append(all, &PartInfo{PartNumber: int32(i), ETag: "md5-" + strconv.Itoa(i)})
+	}
+	var (
+		marker int32
+		pages  []int
+		next   = int32(1)
+	)
+	for {
+		page := &ListPartsResult{Parts: append([]*PartInfo(nil), all...)}
+		page.Paginate(marker, MaxPartsPerList)
+		pages = append(pages, len(page.Parts))
+		for _, p := range page.Parts {
+			if p.PartNumber != next {
+				t.Fatalf("expected part %d, got %d", next, p.PartNumber)
+			}
+			next++
+		}
+		if !page.IsTruncated {
+			if page.NextPartNumberMarker != 0 {
+				t.Fatalf("last page: unexpected next marker %d", page.NextPartNumberMarker)
+			}
+			break
+		}
+		if page.NextPartNumberMarker != next-1 {
+			t.Fatalf("expected next marker %d, got %d", next-1, page.NextPartNumberMarker)
+		}
+		marker = page.NextPartNumberMarker
+	}
+	if len(pages) != 3 || pages[0] != 1000 || pages[1] != 1000 || pages[2] != 500 {
+		t.Fatalf("expected pages of (1000, 1000, 500) parts, got %v", pages)
+	}
+
+	// max-parts is capped
+	page := &ListPartsResult{Parts: append([]*PartInfo(nil), all...)}
+	page.Paginate(0, 5000)
+	if len(page.Parts) != MaxPartsPerList || page.MaxParts != MaxPartsPerList {
+		t.Fatalf("expected max-parts to be capped at %d, got %d", MaxPartsPerList, len(page.Parts))
+	}
+}
+
+func TestListUploadsDelimiter(t *testing.T) {
+	var (
+		now  = time.Now()
+		keys = []string{
+			"a/1", "a/2", "a/b/1", "a/c/1", "a/c/2", "a/d", "b/1", "top",
+		}
+		all = &ListMptUploadsResult{}
+	)
+	for i, key := range keys {
+		all.Uploads = append(all.Uploads, UploadInfoResult{
+			Key:       key,
+			UploadID:  "id-" + strconv.Itoa(i),
+			Initiated: now.Add(time.Duration(i) * time.Second),
+		})
+	}
+	list := func(args *ListMptUploadsArgs) *ListMptUploadsResult {
+		page := &ListMptUploadsResult{Uploads: append([]UploadInfoResult(nil), all.Uploads...)}
+		page.Paginate(args)
+		return page
+	}
+	names := func(page *ListMptUploadsResult) (out []string) {
+		for _, u := range page.Uploads {
+			out = append(out, u.Key)
+		}
+		for _, cp := range page.CommonPrefixes {
+			out = append(out, cp.Prefix)
+		}
+		return out
+	}
+
+	// delimiter only
+	page := list(&ListMptUploadsArgs{Delimiter: "/"})
+	if got := strings.Join(names(page), ","); got != "top,a/,b/" {
+		t.Fatalf("delimiter: got %q", got)
+	}
+
+	// prefix and delimiter
+	page = list(&ListMptUploadsArgs{Prefix: "a/", Delimiter: "/"})
+	if got := strings.Join(names(page), ","); got != "a/1,a/2,a/d,a/b/,a/c/" {
+		t.Fatalf("prefix+delimiter: got %q", got)
+	}
+	if page.Prefix != "a/" || page.Delimiter != "/" {
+		t.Fatalf("expected prefix and delimiter to be echoed, got %q, %q", page.Prefix, page.Delimiter)
+	}
+
+	// prefix only
+	page = list(&ListMptUploadsArgs{Prefix: "a/c/"})
+	if got := strings.Join(names(page), ","); got != "a/c/1,a/c/2" {
+		t.Fatalf("prefix: got %q", got)
+	}
+
+	// paginate with roll-ups (each common prefix counts as one entry)
+	var (
+		args = &ListMptUploadsArgs{Prefix: "a/", Delimiter: "/", MaxUploads: 2}
+		seen []string
+	)
+	for range len(keys) {
+		page = list(args)
+		seen = append(seen, names(page)...)
+		if !page.IsTruncated {
+			break
+		}
+		if page.NextKeyMarker == "" {
+			t.Fatal("truncated page without next key marker")
+		}
+		args.KeyMarker, args.UploadIDMarker = page.NextKeyMarker, page.NextUploadIDMarker
+	}
+	if got := strings.Join(seen, ","); got != "a/1,a/2,a/b/,a/c/,a/d" {
+		t.Fatalf("paginated: got %q", got)
+	}
+
+	// key-marker without upload-id-marker skips the key itself
+	page = list(&ListMptUploadsArgs{KeyMarker: "a/d"})
+	if got := strings.Join(names(page), ","); got != "b/1,top" {
+		t.Fatalf("key-marker: got %q", got)
+	}
+}
+
+func TestCheckPartsList(t *testing.T) {
+	const id = "test-upload-part-list"
+	InitUpload(id, newTestLOM(t, "obj"), "")
+	defer CleanupUpload(id, "", true /*aborted*/)
+
+	for num := int32(1); num <= 3; num++ {
+		md5 := "md5-" + strconv.Itoa(int(num))
+		if err := AddPart(id, &MptPart{Num: num, Size: MinPartSize, MD5: md5}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		name  string
+		parts []*PartInfo
+		code  string
+	}{
+		{"valid", []*PartInfo{{PartNumber: 1, ETag: `"md5-1"`}, {PartNumber: 2, ETag: "md5-2"}, {PartNumber: 3}}, ""},
+		{"etag mismatch", []*PartInfo{{PartNumber: 1, ETag: "md5-1"}, {PartNumber: 2, ETag: "md5-3"}}, "InvalidPart"},
+		{"missing part", []*PartInfo{{PartNumber: 1}, {PartNumber: 4}}, "InvalidPart"},
+		{"descending", []*PartInfo{{PartNumber: 2}, {PartNumber: 1}}, "InvalidPartOrder"},
+		{"duplicate", []*PartInfo{{PartNumber: 1}, {PartNumber: 1}}, "InvalidPartOrder"},
+	}
+	for _, test := range tests {
+		_, err := CheckParts(id, test.parts)
+		if test.code == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", test.name, err)
+			}
+			continue
+		}
+		if code := errCode(err); code != test.code {
+			t.Errorf("%s: expected %s, got %q (%v)", test.name, test.code, code, err)
+		}
+	}
+	_, err := CheckParts(id, []*PartInfo{{PartNumber: 1}, {PartNumber: 2, ETag: "bad"}})
+	if s := err.Error(); !strings.Contains(s, "part 2") {
+		t.Fatalf("expected error to name the offending part: %q", s)
+	}
+}
+
+func TestAddPartReupload(t *testing.T) {
+	const id = "test-upload-part-reupload"
+	InitUpload(id, newTestLOM(t, "obj"), "")
+	defer CleanupUpload(id, "", true /*aborted*/)
+
+	for _, part := range []*MptPart{
+		{Num: 1, Size: cos.KiB, MD5: "md5-old"}, // (undersized)
+		{Num: 2, Size: cos.KiB, MD5: "md5-2"},
+		{Num: 1, Size: MinPartSize, MD5: "md5-new"}, // re-upload
+	} {
+		if err := AddPart(id, part); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mu.RLock()
+	nparts := len(ups[id].parts)
+	mu.RUnlock()
+	if nparts != 2 {
+		t.Fatalf("expected 2 parts, got %d", nparts)
+	}
+
+	// complete with the new ETag
+	parts, err := CheckParts(id, []*PartInfo{{PartNumber: 1, ETag: "md5-new"}, {PartNumber: 2, ETag: "md5-2"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parts[0].MD5 != "md5-new" || parts[0].Size != MinPartSize {
+		t.Fatalf("expected re-uploaded part 1, got %+v", parts[0])
+	}
+	if _, err := CheckParts(id, []*PartInfo{{PartNumber: 1, ETag: "md5-old"}, {PartNumber: 2}}); errCode(err) != "InvalidPart" {
+		t.Fatalf("expected InvalidPart for the stale ETag, got %v", err)
+	}
+}
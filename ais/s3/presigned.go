@@ -47,6 +47,8 @@ func NewPresignedReq(oreq *http.Request, lom *core.LOM, body io.ReadCloser, q ur
 	return &PresignedReq{oreq, lom, body, q}
 }
 
+func (pts *PresignedReq) SetBody(body io.ReadCloser) { pts.body = body }
+
 // FIXME: handle error cases
 func parseSignatureV4(query url.Values, header http.Header) (region string) {
 	if credentials := query.Get(HeaderCredentials); credentials != "" {
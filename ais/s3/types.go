@@ -9,7 +9,9 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -55,6 +57,11 @@ type (
 		LastModified string `xml:"LastModified"` // e.g. <LastModified>2009-10-12T17:50:30.000Z</LastModified>
 		ETag         string `xml:"ETag"`
 	}
+	// UploadPartCopy response
+	CopyPartResult struct {
+		LastModified string `xml:"LastModified"`
+		ETag         string `xml:"ETag"`
+	}
 
 	// Multipart upload start response
 	InitiateMptUploadResult struct {
@@ -84,10 +91,14 @@ type (
 
 	// Multipart uploaded parts response
 	ListPartsResult struct {
-		Bucket   string      `xml:"Bucket"`
-		Key      string      `xml:"Key"`
-		UploadID string      `xml:"UploadId"`
-		Parts    []*PartInfo `xml:"Part"`
+		Bucket               string      `xml:"Bucket"`
+		Key                  string      `xml:"Key"`
+		UploadID             string      `xml:"UploadId"`
+		PartNumberMarker     int32       `xml:"PartNumberMarker"`
+		NextPartNumberMarker int32       `xml:"NextPartNumberMarker,omitempty"`
+		MaxParts             int         `xml:"MaxParts"`
+		IsTruncated          bool        `xml:"IsTruncated"`
+		Parts                []*PartInfo `xml:"Part"`
 	}
 
 	// Active upload info
@@ -99,11 +110,25 @@ type (
 
 	// List of active multipart uploads response
 	ListMptUploadsResult struct {
-		Bucket         string             `xml:"Bucket"`
-		UploadIDMarker string             `xml:"UploadIdMarker"`
-		Uploads        []UploadInfoResult `xml:"Upload"`
-		MaxUploads     int
-		IsTruncated    bool
+		Bucket             string             `xml:"Bucket"`
+		KeyMarker          string             `xml:"KeyMarker"`
+		UploadIDMarker     string             `xml:"UploadIdMarker"`
+		NextKeyMarker      string             `xml:"NextKeyMarker,omitempty"`
+		NextUploadIDMarker string             `xml:"NextUploadIdMarker,omitempty"`
+		Prefix             string             `xml:"Prefix,omitempty"`
+		Delimiter          string             `xml:"Delimiter,omitempty"`
+		Uploads            []UploadInfoResult `xml:"Upload"`
+		CommonPrefixes     []*CommonPrefix    `xml:"CommonPrefixes,omitempty"`
+		MaxUploads         int
+		IsTruncated        bool
+	}
+	// ListMultipartUploads request (query)
+	ListMptUploadsArgs struct {
+		Prefix         string
+		Delimiter      string
+		KeyMarker      string
+		UploadIDMarker string
+		MaxUploads     int // 0: unlimited
 	}
 
 	// Deleted result: list of deleted objects and errors
@@ -188,11 +213,12 @@ func (r *ListObjectResult) FromLsoResult(lst *cmn.LsoRes, lsmsg *apc.LsoMsg) {
 	}
 }
 
+// ETag: custom metadata (including multipart "<md5-of-md5s>-<num-parts>"), otherwise MD5 checksum, if any
 func SetEtag(hdr http.Header, lom *core.LOM) {
 	if hdr.Get(cos.S3CksumHeader) != "" {
 		return
 	}
-	if v, exists := lom.GetCustomKey(cmn.ETag); exists && !cmn.IsS3MultipartEtag(v) {
+	if v, exists := lom.GetCustomKey(cmn.ETag); exists {
 		hdr.Set(cos.S3CksumHeader /*"ETag"*/, v)
 		return
 	}
@@ -207,6 +233,12 @@ func (r *CopyObjectResult) MustMarshal(sgl *memsys.SGL) {
 	debug.AssertNoErr(err)
 }
 
+func (r *CopyPartResult) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
 func (r *InitiateMptUploadResult) MustMarshal(sgl *memsys.SGL) {
 	sgl.Write([]byte(xml.Header))
 	err := xml.NewEncoder(sgl).Encode(r)
@@ -225,6 +257,137 @@ func (r *ListPartsResult) MustMarshal(sgl *memsys.SGL) {
 	debug.AssertNoErr(err)
 }
 
+// sort parts by part number and return (at most) `maxParts` that follow `marker`
+func (r *ListPartsResult) Paginate(marker int32, maxParts int) {
+	if maxParts <= 0 || maxParts > MaxPartsPerList {
+		maxParts = MaxPartsPerList
+	}
+	sort.Slice(r.Parts, func(i, j int) bool { return r.Parts[i].PartNumber < r.Parts[j].PartNumber })
+	r.PartNumberMarker, r.MaxParts = marker, maxParts
+	if marker > 0 {
+		i := sort.Search(len(r.Parts), func(i int) bool { return r.Parts[i].PartNumber > marker })
+		r.Parts = r.Parts[i:]
+	}
+	r.IsTruncated, r.NextPartNumberMarker = false, 0
+	if len(r.Parts) > maxParts {
+		r.Parts = r.Parts[:maxParts]
+		r.IsTruncated = true
+		r.NextPartNumberMarker = r.Parts[maxParts-1].PartNumber
+	}
+}
+
+func NewListMptUploadsArgs(q url.Values) *ListMptUploadsArgs {
+	args := &ListMptUploadsArgs{
+		Prefix:         q.Get(QparamPrefix),
+		Delimiter:      q.Get(QparamDelimiter),
+		KeyMarker:      q.Get(QparamMptKeyMarker),
+		UploadIDMarker: q.Get(QparamMptUploadIDMarker),
+	}
+	if v, err := strconv.Atoi(q.Get(QparamMptMaxUploads)); err == nil && v > 0 {
+		args.MaxUploads = v
+	}
+	return args
+}
+
+// Filter uploads by prefix, sort them by key and initiation time, and return (at most)
+// `args.MaxUploads` entries that follow the markers; with delimiter, keys that contain it
+// (after the prefix) are rolled up into common prefixes - one entry each.
+// NOTE: upload-id-marker without key-marker is supported for backward compatibility
+// (S3 ignores it).
+func (r *ListMptUploadsResult) Paginate(args *ListMptUploadsArgs) {
+	uploads := r.Uploads[:0]
+	for i := range r.Uploads {
+		if strings.HasPrefix(r.Uploads[i].Key, args.Prefix) {
+			uploads = append(uploads, r.Uploads[i])
+		}
+	}
+	sort.Slice(uploads, func(i, j int) bool {
+		a, b := &uploads[i], &uploads[j]
+		switch {
+		case a.Key != b.Key:
+			return a.Key < b.Key
+		case !a.Initiated.Equal(b.Initiated):
+			return a.Initiated.Before(b.Initiated)
+		default:
+			return a.UploadID < b.UploadID
+		}
+	})
+	r.Prefix, r.Delimiter = args.Prefix, args.Delimiter
+	r.KeyMarker, r.UploadIDMarker, r.MaxUploads = args.KeyMarker, args.UploadIDMarker, args.MaxUploads
+
+	// markers
+	switch {
+	case args.KeyMarker != "":
+		i := sort.Search(len(uploads), func(i int) bool { return uploads[i].Key >= args.KeyMarker })
+		if args.UploadIDMarker != "" {
+			// resume after the marked upload of the same key
+			for j := i; j < len(uploads) && uploads[j].Key == args.KeyMarker; j++ {
+				if uploads[j].UploadID == args.UploadIDMarker {
+					i = j + 1
+					break
+				}
+			}
+		} else {
+			for i < len(uploads) && uploads[i].Key == args.KeyMarker {
+				i++
+			}
+		}
+		// key-marker is a common prefix (returned by the previous page) - skip all keys under it
+		if args.Delimiter != "" && strings.HasSuffix(args.KeyMarker, args.Delimiter) {
+			for i < len(uploads) && strings.HasPrefix(uploads[i].Key, args.KeyMarker) {
+				i++
+			}
+		}
+		uploads = uploads[i:]
+	case args.UploadIDMarker != "":
+		for i := range uploads {
+			if uploads[i].UploadID == args.UploadIDMarker {
+				uploads = uploads[i+1:]
+				break
+			}
+		}
+	}
+
+	// page (and roll up)
+	r.Uploads, r.CommonPrefixes = uploads[:0], nil
+	r.IsTruncated, r.NextKeyMarker, r.NextUploadIDMarker = false, "", ""
+	var (
+		cnt       int
+		last      string
+		lastIsDir bool
+	)
+	for i := range uploads {
+		var cp string
+		if args.Delimiter != "" {
+			rest := uploads[i].Key[len(args.Prefix):]
+			if k := strings.Index(rest, args.Delimiter); k >= 0 {
+				cp = args.Prefix + rest[:k+len(args.Delimiter)]
+				if lastIsDir && cp == last {
+					continue
+				}
+			}
+		}
+		if args.MaxUploads > 0 && cnt == args.MaxUploads {
+			r.IsTruncated = true
+			break
+		}
+		cnt++
+		if cp != "" {
+			r.CommonPrefixes = append(r.CommonPrefixes, &CommonPrefix{Prefix: cp})
+			last, lastIsDir = cp, true
+			continue
+		}
+		r.Uploads = append(r.Uploads, uploads[i])
+		last, lastIsDir = uploads[i].Key, false
+	}
+	if r.IsTruncated {
+		r.NextKeyMarker = last
+		if !lastIsDir {
+			r.NextUploadIDMarker = r.Uploads[len(r.Uploads)-1].UploadID
+		}
+	}
+}
+
 func (r *ListMptUploadsResult) MustMarshal(sgl *memsys.SGL) {
 	sgl.Write([]byte(xml.Header))
 	err := xml.NewEncoder(sgl).Encode(r)
@@ -32,6 +32,15 @@ func OffsetSorted(lom *core.LOM, partNum int32) (off, size int64, status int, er
 	return
 }
 
+// number of parts the object was assembled from (zero if not multipart-uploaded)
+func NumParts(lom *core.LOM) (int, error) {
+	mpt, err := loadMptXattr(lom.FQN)
+	if err != nil || mpt == nil {
+		return 0, err
+	}
+	return len(mpt.parts), nil
+}
+
 func loadMptXattr(fqn string) (out *mpt, err error) {
 	b, err := fs.GetXattr(fqn, mptXattrID)
 	if err == nil {
@@ -111,10 +120,17 @@ func (mpt *mpt) unpack(b []byte) (err error) {
 }
 
 func (mpt *mpt) getPart(num int32) *MptPart {
-	for _, part := range mpt.parts {
+	if i := mpt.partIdx(num); i >= 0 {
+		return mpt.parts[i]
+	}
+	return nil
+}
+
+func (mpt *mpt) partIdx(num int32) int {
+	for i, part := range mpt.parts {
 		if part.Num == num {
-			return part
+			return i
 		}
 	}
-	return nil
+	return -1
 }
@@ -39,6 +39,7 @@ import (
 	"github.com/NVIDIA/aistore/ext/etl"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/fs/health"
+	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/mirror"
 	"github.com/NVIDIA/aistore/reb"
@@ -377,10 +378,18 @@ func (t *target) Run() error {
 	t.initRecvHandlers()
 
 	ec.Init()
-	mirror.Init()
+	mirror.Init(t.statsT)
 
 	xreg.RegWithHK()
 
+	// rehydrate multipart uploads interrupted by the restart (and take ownership
+	// of their parts) - must precede workfile GC
+	s3.LoadActiveUploads()
+	hk.Reg(apc.ActMptCleanup+hk.NameSuffix, t.housekeepMpt, hk.DelOldIval)
+
+	// GC workfiles abandoned by the previous run (if any)
+	go t.rmOldWorkfiles(config)
+
 	marked := xreg.GetResilverMarked()
 	if marked.Interrupted || daemon.resilver.required {
 		go t.goreslver(marked.Interrupted)
@@ -418,6 +427,18 @@ func (t *target) gojoin(config *cmn.Config) {
 	}
 }
 
+func (t *target) rmOldWorkfiles(config *cmn.Config) {
+	var (
+		bmd  = t.owner.bmd.get()
+		bcks = make([]cmn.Bck, 0, 16)
+	)
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		bcks = append(bcks, *bck.Bucket())
+		return false
+	})
+	fs.RmOldWorkfiles(bcks, config.Space.WorkfileTTL.D())
+}
+
 func (t *target) goreslver(interrupted bool) {
 	if interrupted {
 		nlog.Infoln("Resuming resilver...")
@@ -1387,21 +1408,15 @@ func (t *target) objMv(lom *core.LOM, msg *apc.ActMsg) (err error) {
 		coiParams.Config = cmn.GCO.Get()
 		coiParams.OWT = cmn.OwtCopy
 		coiParams.Finalize = true
+		coiParams.DeleteSrc = true // local destination: rename without copying (when on the same mountpath)
 	}
 	coi := (*copyOI)(coiParams)
 	_, err = coi.do(t, nil /*DM*/, lom)
 	core.FreeCOI(coiParams)
 	slab.Free(buf)
-	if err != nil {
+	if err != nil && err != cmn.ErrSkip { // (ErrSkip: identical destination)
 		return err
 	}
-
-	// TODO: combine copy+delete under a single write lock
-	lom.Lock(true)
-	if err := lom.Remove(); err != nil {
-		nlog.Warningf("%s: failed to delete renamed object %s (new name %s): %v", t, lom, msg.Name, err)
-	}
-	lom.Unlock(true)
 	return nil
 }
 
@@ -305,12 +305,24 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		ds.Tracker = daeStats.Tracker
 		t.writeJSON(w, r, ds, httpdaeWhat)
 	case apc.WhatNodeStatsAndStatus:
+		secs := newNsSections(query)
 		ds := t.statsAndStatus()
-		ds.RebSnap = _rebSnap()
-		daeStats := t.statsT.GetStats()
-		ds.Tracker = daeStats.Tracker
-		ds.TargetCDF = daeStats.TargetCDF
-		t.ciiFill(&ds.Cluster)
+		if secs.has(apc.NodeStatusRebalance) {
+			ds.RebSnap = _rebSnap()
+		}
+		if secs.has(apc.NodeStatusCapacity) {
+			daeStats := t.statsT.GetStats()
+			ds.TargetCDF = daeStats.TargetCDF
+			if secs.has(apc.NodeStatusMetrics) {
+				ds.Tracker = daeStats.Tracker
+			}
+		}
+		if ds.Tracker == nil {
+			t.nsMetrics(ds, secs)
+		}
+		if secs.has(apc.NodeStatusCluster) {
+			t.ciiFill(&ds.Cluster)
+		}
 		t.writeJSON(w, r, ds, httpdaeWhat)
 	case apc.WhatNodeStatsAndStatusV322: // [ditto]
 		ds := t.statsAndStatusV322()
@@ -21,6 +21,7 @@ import (
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ext/etl"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/stats"
 )
 
 // [METHOD] /v1/etl
@@ -155,8 +156,15 @@ func (t *target) stopETL(w http.ResponseWriter, r *http.Request, etlName string)
 func (t *target) getETL(w http.ResponseWriter, r *http.Request, etlName string, bck *meta.Bck, objName string) {
 	var (
 		comm etl.Communicator
+		rng  *etl.ObjRange
 		err  error
 	)
+	if s := r.URL.Query().Get(apc.QparamETLRange); s != "" {
+		if rng, err = etl.ParseObjRange(s); err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+	}
 	comm, err = etl.GetCommunicator(etlName)
 	if err != nil {
 		if cos.IsErrNotFound(err) {
@@ -169,16 +177,37 @@ func (t *target) getETL(w http.ResponseWriter, r *http.Request, etlName string,
 		t.writeErr(w, r, err)
 		return
 	}
-	if err := comm.InlineTransform(w, r, bck, objName); err != nil {
+	if err := comm.InlineTransform(w, r, bck, objName, rng); err != nil {
+		if cos.IsNotExist(err, 0) {
+			t.writeErr(w, r, err, http.StatusNotFound, Silent)
+			return
+		}
+		if cmn.IsErrRangeNotSatisfiable(err) {
+			t.writeErr(w, r, err, http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
 		errV := cmn.NewErrETL(&cmn.ETLErrCtx{ETLName: etlName, PodName: comm.PodName(), SvcName: comm.SvcName()},
 			err.Error())
 		xetl := comm.Xact()
 		xetl.AddErr(errV)
+		if etl.IsErrObjTimeout(err) {
+			t.statsT.IncErr(stats.ErrETLTimeoutCount)
+			t.writeErr(w, r, errV, http.StatusGatewayTimeout)
+			return
+		}
+		if etl.IsErrDraining(err) {
+			t.writeErr(w, r, errV, http.StatusServiceUnavailable)
+			return
+		}
 		t.writeErr(w, r, errV)
 	}
 }
 
 func (t *target) logsETL(w http.ResponseWriter, r *http.Request, etlName string) {
+	if q := r.URL.Query(); q.Has(apc.QparamLogTail) || q.Has(apc.QparamLogFollow) {
+		t.streamLogsETL(w, r, etlName, q)
+		return
+	}
 	logs, err := etl.PodLogs(etlName)
 	if err != nil {
 		t.writeErr(w, r, err)
@@ -187,6 +216,32 @@ func (t *target) logsETL(w http.ResponseWriter, r *http.Request, etlName string)
 	t.writeJSON(w, r, logs, "logs-etl")
 }
 
+// plain-text logs of the ETL pod, flushed as they arrive when following
+func (t *target) streamLogsETL(w http.ResponseWriter, r *http.Request, etlName string, q url.Values) {
+	opts := &k8s.PodLogsOpts{Follow: cos.IsParseBool(q.Get(apc.QparamLogFollow))}
+	if s := q.Get(apc.QparamLogTail); s != "" {
+		tail, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || tail < 0 {
+			t.writeErrf(w, r, "invalid %s=%q (expecting non-negative integer)", apc.QparamLogTail, s)
+			return
+		}
+		opts.Tail = tail
+	}
+	if _, err := etl.GetCommunicator(etlName); err != nil {
+		t.writeErr(w, r, err, http.StatusNotFound)
+		return
+	}
+	w.Header().Set(cos.HdrContentType, cos.ContentText)
+	var ww io.Writer = w
+	if flusher, ok := w.(http.Flusher); ok && opts.Follow {
+		ww = cos.NewCallbackWriter(w, func(int) { flusher.Flush() })
+	}
+	if err := etl.StreamPodLogs(r.Context(), etlName, opts, ww); err != nil {
+		// (headers may have been sent already)
+		nlog.Warningln(t.String(), "stream logs", etlName, "failed:", err)
+	}
+}
+
 func (t *target) healthETL(w http.ResponseWriter, r *http.Request, etlName string) {
 	health, err := etl.PodHealth(etlName)
 	if err != nil {
@@ -221,20 +276,23 @@ func etlParseObjectReq(_ http.ResponseWriter, r *http.Request) (secret string, b
 		return
 	}
 	secret = items[0]
-	// Encoding is done in `transformerPath`.
-	var uname string
-	uname, err = url.PathUnescape(items[1])
+	// encoding is done in `transformerPath`
+	var b cmn.Bck
+	b, objName, err = cmn.DecodeUname(items[1])
 	if err != nil {
-		return
+		// backward compatibility: escaped uname
+		var uname string
+		if uname, err = url.PathUnescape(items[1]); err != nil {
+			return
+		}
+		b, objName = cmn.ParseUname(uname)
 	}
-	var b cmn.Bck
-	b, objName = cmn.ParseUname(uname)
 	if err = b.Validate(); err != nil {
-		err = fmt.Errorf("%v, uname=%q", err, uname)
+		err = fmt.Errorf("%v, uname=%q", err, items[1])
 		return
 	}
 	if objName == "" {
-		err = fmt.Errorf("object name is missing (bucket=%s, uname=%q)", b, uname)
+		err = fmt.Errorf("object name is missing (bucket=%s, uname=%q)", b, items[1])
 		return
 	}
 	bck = meta.CloneBck(&b)
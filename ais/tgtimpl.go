@@ -138,6 +138,9 @@ func (t *target) HeadObjT2T(lom *core.LOM, si *meta.Snode) bool {
 //     the AIS cluster (by performing a cold GET if need be).
 //   - if the dst is cloud, we perform a regular PUT logic thus also making sure that the new
 //     replica gets created in the cloud bucket of _this_ AIS cluster.
+//
+// Returns cmn.ErrSkip when the destination is left intact (identical or, with
+// `SkipExist`, already existing) - callers count those separately.
 func (t *target) CopyObject(lom *core.LOM, dm core.DM, params *core.CopyParams) (size int64, err error) {
 	coi := (*copyOI)(params)
 	// defaults
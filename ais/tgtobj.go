@@ -31,6 +31,7 @@ import (
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ec"
+	"github.com/NVIDIA/aistore/ext/etl"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/mirror"
@@ -1324,7 +1325,14 @@ func (coi *copyOI) do(t *target, dm *bundle.DataMover, lom *core.LOM) (size int6
 		return 0, errN
 	}
 	if tsi.ID() != t.SID() {
-		return coi.send(t, dm, lom, coi.ObjnameTo, tsi)
+		if coi.SkipExist && coi.existsAt(t, tsi) {
+			return 0, cmn.ErrSkip
+		}
+		size, err = coi.send(t, dm, lom, coi.ObjnameTo, tsi)
+		if err == nil && coi.DeleteSrc && dm == nil {
+			delCopySrc(t, coi.Xact, lom) // (when sending via data mover: upon successful transmission - see _dm)
+		}
+		return size, err
 	}
 
 	// dst is this target
@@ -1334,18 +1342,63 @@ func (coi *copyOI) do(t *target, dm *bundle.DataMover, lom *core.LOM) (size int6
 		core.FreeLOM(dst)
 		return 0, err
 	}
-	if coi.DP != nil {
+	if coi.SkipExist && dst.Load(false /*cache it*/, false /*locked*/) == nil {
+		core.FreeLOM(dst)
+		return 0, cmn.ErrSkip
+	}
+	var renamed bool
+	switch {
+	case coi.DP != nil:
 		var ecode int
 		size, ecode, err = coi._reader(t, dm, lom, dst)
 		debug.Assert(ecode != http.StatusNotFound || cos.IsNotExist(err, 0), err, ecode)
-	} else {
+	case coi.DeleteSrc && coi.canRename(lom, dst):
+		size, err = coi._rename(t, lom, dst)
+		renamed = err == nil
+	default:
 		size, err = coi._regular(t, lom, dst)
 	}
 	core.FreeLOM(dst)
 
+	// identical destination (ErrSkip) counts as a successful copy
+	if coi.DeleteSrc && !renamed && (err == nil || err == cmn.ErrSkip) && !coi.sameObj(lom) {
+		delCopySrc(t, coi.Xact, lom)
+	}
 	return size, err
 }
 
+// local rename is limited to plain ais:// buckets with no erasure coding:
+// remote backends and EC metadata (slices, replicas) must go through the regular copy and delete
+func (coi *copyOI) canRename(lom, dst *core.LOM) bool {
+	return lom.Bck().IsAIS() && coi.BckTo.IsAIS() && !lom.ECEnabled() && !dst.ECEnabled() && !coi.sameObj(lom)
+}
+
+func (coi *copyOI) sameObj(lom *core.LOM) bool {
+	return lom.ObjName == coi.ObjnameTo && lom.Bck().Equal(coi.BckTo, true, true)
+}
+
+// (move) remove source object upon successful copy
+func delCopySrc(t *target, xctn core.Xact, lom *core.LOM) {
+	if _, err := t.DeleteObject(lom, false /*evict*/); err != nil && !cos.IsNotExist(err, 0) {
+		err = fmt.Errorf("failed to delete copied source %s: %w", lom.Cname(), err)
+		if xctn != nil {
+			xctn.AddErr(err, 4)
+		} else {
+			nlog.Errorln(err)
+		}
+	}
+}
+
+// (skip-existing) check whether the destination is present at its designated target
+func (coi *copyOI) existsAt(t *target, tsi *meta.Snode) bool {
+	dst := core.AllocLOM(coi.ObjnameTo)
+	defer core.FreeLOM(dst)
+	if err := dst.InitBck(coi.BckTo.Bucket()); err != nil {
+		return false
+	}
+	return t.HeadObjT2T(dst, tsi)
+}
+
 func (coi *copyOI) _dryRun(lom *core.LOM, objnameTo string) (size int64, err error) {
 	if coi.DP == nil {
 		if lom.Uname() != coi.BckTo.MakeUname(objnameTo) {
@@ -1354,6 +1407,11 @@ func (coi *copyOI) _dryRun(lom *core.LOM, objnameTo string) (size int64, err err
 		return size, nil
 	}
 
+	// ETL: estimate without contacting the container
+	if dp, ok := coi.DP.(*etl.OfflineDP); ok {
+		return dp.DryRun(lom)
+	}
+
 	// discard the reader and be done
 	var reader io.ReadCloser
 	if reader, _, err = coi.DP.Reader(lom, false, false); err != nil {
@@ -1429,8 +1487,8 @@ func (coi *copyOI) _regular(t *target, lom, dst *core.LOM) (size int64, _ error)
 		dst.Lock(true)
 		defer dst.Unlock(true)
 		if err := dst.Load(false /*cache it*/, true /*locked*/); err == nil {
-			if lom.EqCksum(dst.Checksum()) {
-				return 0, nil
+			if !coi.Overwrite && lom.EqCksum(dst.Checksum()) {
+				return 0, cmn.ErrSkip // unchanged
 			}
 		} else if cmn.IsErrBucketNought(err) {
 			return 0, err
@@ -1449,6 +1507,37 @@ func (coi *copyOI) _regular(t *target, lom, dst *core.LOM) (size int64, _ error)
 	return size, err
 }
 
+// move (ais) object within this target - see core.LOM.RenameObject
+func (coi *copyOI) _rename(t *target, lom, dst *core.LOM) (size int64, _ error) {
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if !cos.IsNotExist(err, 0) {
+			err = cmn.NewErrFailedTo(t, "coi-load", lom, err)
+		}
+		return 0, err
+	}
+	dst.Lock(true)
+	defer dst.Unlock(true)
+	if err := dst.Load(false /*cache it*/, true /*locked*/); err == nil {
+		if !coi.Overwrite && lom.EqCksum(dst.Checksum()) {
+			return 0, cmn.ErrSkip // unchanged (the source gets deleted by the caller)
+		}
+	} else if cmn.IsErrBucketNought(err) {
+		return 0, err
+	}
+	size = lom.SizeBytes()
+	dst2, err := lom.RenameObject(dst.Bck(), dst.ObjName, coi.Buf)
+	if err != nil {
+		return 0, err
+	}
+	if coi.Finalize {
+		t.putMirror(dst2)
+	}
+	core.FreeLOM(dst2)
+	return size, nil
+}
+
 // send object => designated target
 // * source is a LOM or a reader (that may be reading from remote)
 // * one of the two equivalent transmission mechanisms: PUT or transport Send
@@ -1526,7 +1615,7 @@ func (coi *copyOI) _send(t *target, lom *core.LOM, sargs *sendArgs) (size int64,
 	var err error
 	sargs.bckTo = coi.BckTo
 	if sargs.dm != nil {
-		err = coi._dm(lom /*for attrs*/, sargs)
+		err = coi._dm(t, lom /*for attrs*/, sargs)
 	} else {
 		err = coi.put(t, sargs)
 	}
@@ -1535,16 +1624,22 @@ func (coi *copyOI) _send(t *target, lom *core.LOM, sargs *sendArgs) (size int64,
 
 // use data mover to transmit objects to other targets
 // (compare with coi.put())
-func (coi *copyOI) _dm(lom *core.LOM, sargs *sendArgs) error {
+func (coi *copyOI) _dm(t *target, lom *core.LOM, sargs *sendArgs) error {
 	debug.Assert(sargs.dm.OWT() == sargs.owt)
 	debug.Assert(sargs.dm.GetXact() == coi.Xact || sargs.dm.GetXact().ID() == coi.Xact.ID())
-	o := transport.AllocSend()
-	hdr, oa := &o.Hdr, sargs.objAttrs
+	var (
+		o       = transport.AllocSend()
+		hdr, oa = &o.Hdr, sargs.objAttrs
+	)
 	{
 		hdr.Bck.Copy(sargs.bckTo.Bucket())
 		hdr.ObjName = sargs.objNameTo
 		hdr.ObjAttrs.CopyFrom(oa, false /*skip cksum*/)
 	}
+	if coi.DeleteSrc {
+		// move: the source gets deleted only upon receiver's ACK (that carries source uname back)
+		hdr.Opaque = []byte(lom.Uname())
+	}
 	o.Callback = func(_ *transport.ObjHdr, _ io.ReadCloser, _ any, _ error) {
 		core.FreeLOM(lom)
 	}
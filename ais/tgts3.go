@@ -6,7 +6,6 @@ package ais
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -81,9 +80,10 @@ func (t *target) putCopyMpt(w http.ResponseWriter, r *http.Request, config *cmn.
 	switch {
 	case q.Has(s3.QparamMptPartNo) && q.Has(s3.QparamMptUploadID):
 		if r.Header.Get(cos.S3HdrObjSrc) != "" {
-			// TODO: copy another object (or its range) => part of the specified multipart upload.
-			// https://docs.aws.amazon.com/AmazonS3/latest/API/API_UploadPartCopy.html
-			s3.WriteErr(w, r, errors.New("UploadPartCopy not implemented yet"), http.StatusNotImplemented)
+			if cmn.Rom.FastV(5, cos.SmoduleS3) {
+				nlog.Infoln("putMptPartCopy", bck.String(), items, q)
+			}
+			t.putMptPartCopy(w, r, items, q, bck)
 			return
 		}
 		if cmn.Rom.FastV(5, cos.SmoduleS3) {
@@ -103,36 +103,13 @@ func (t *target) putCopyMpt(w http.ResponseWriter, r *http.Request, config *cmn.
 // Copy object (maybe from another bucket)
 // https://docs.aws.amazon.com/AmazonS3/latest/API/API_CopyObject.html
 func (t *target) copyObjS3(w http.ResponseWriter, r *http.Request, config *cmn.Config, items []string) {
-	src := r.Header.Get(cos.S3HdrObjSrc)
-	src = strings.Trim(src, "/") // in AWS examples the path starts with "/"
-	parts := strings.SplitN(src, "/", 2)
-	if len(parts) < 2 {
-		s3.WriteErr(w, r, errS3Obj, 0)
-		return
-	}
 	// src
-	bckSrc, err, ecode := meta.InitByNameOnly(parts[0], t.owner.bmd)
+	lom, err, ecode := t.initS3Src(r)
 	if err != nil {
 		s3.WriteErr(w, r, err, ecode)
 		return
 	}
-	objSrc := strings.Trim(parts[1], "/")
-	if err := bckSrc.Init(t.owner.bmd); err != nil {
-		s3.WriteErr(w, r, err, 0)
-		return
-	}
-	lom := core.AllocLOM(objSrc)
 	defer core.FreeLOM(lom)
-	if err := lom.InitBck(bckSrc.Bucket()); err != nil {
-		if cmn.IsErrRemoteBckNotFound(err) {
-			t.BMDVersionFixup(r)
-			err = lom.InitBck(bckSrc.Bucket())
-		}
-		if err != nil {
-			s3.WriteErr(w, r, err, 0)
-		}
-		return
-	}
 	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
@@ -155,13 +132,8 @@ func (t *target) copyObjS3(w http.ResponseWriter, r *http.Request, config *cmn.C
 	_, err = coi.do(t, nil /*DM*/, lom)
 	core.FreeCOI(coiParams)
 
-	if err != nil {
-		if err == cmn.ErrSkip {
-			name := lom.Cname()
-			s3.WriteErr(w, r, cos.NewErrNotFound(t, name), http.StatusNotFound)
-		} else {
-			s3.WriteErr(w, r, err, 0)
-		}
+	if err != nil && err != cmn.ErrSkip { // (ErrSkip: identical destination)
+		s3.WriteErr(w, r, err, 0)
 		return
 	}
 
@@ -180,6 +152,37 @@ func (t *target) copyObjS3(w http.ResponseWriter, r *http.Request, config *cmn.C
 	sgl.Free()
 }
 
+// parse `x-amz-copy-source` (CopyObject, UploadPartCopy) and initialize the source LOM
+// (the caller must free)
+func (t *target) initS3Src(r *http.Request) (*core.LOM, error, int) {
+	src := r.Header.Get(cos.S3HdrObjSrc)
+	src = strings.Trim(src, "/") // in AWS examples the path starts with "/"
+	parts := strings.SplitN(src, "/", 2)
+	if len(parts) < 2 {
+		return nil, errS3Obj, 0
+	}
+	bckSrc, err, ecode := meta.InitByNameOnly(parts[0], t.owner.bmd)
+	if err != nil {
+		return nil, err, ecode
+	}
+	objSrc := strings.Trim(parts[1], "/")
+	if err := bckSrc.Init(t.owner.bmd); err != nil {
+		return nil, err, 0
+	}
+	lom := core.AllocLOM(objSrc)
+	if err := lom.InitBck(bckSrc.Bucket()); err != nil {
+		if cmn.IsErrRemoteBckNotFound(err) {
+			t.BMDVersionFixup(r)
+			err = lom.InitBck(bckSrc.Bucket())
+		}
+		if err != nil {
+			core.FreeLOM(lom)
+			return nil, err, 0
+		}
+	}
+	return lom, nil, 0
+}
+
 func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, config *cmn.Config, lom *core.LOM) {
 	if err := lom.InitBck(bck.Bucket()); err != nil {
 		if cmn.IsErrRemoteBckNotFound(err) {
@@ -327,6 +330,14 @@ func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []strin
 		hdr.Set(cos.HdrETag, v)
 	}
 	s3.SetEtag(hdr, lom)
+	if exists {
+		// (clients use it to decide on download concurrency)
+		if n, err := s3.NumParts(lom); err != nil {
+			nlog.Warningln(lom.Cname(), "failed to load multipart state:", err)
+		} else if n > 0 {
+			hdr.Set(cos.S3HdrMptCnt, strconv.Itoa(n))
+		}
+	}
 	hdr.Set(cos.HdrContentLength, strconv.FormatInt(op.Size, 10))
 	if v, ok := custom[cos.HdrContentType]; ok {
 		hdr.Set(cos.HdrContentType, v)
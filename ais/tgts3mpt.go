@@ -6,6 +6,7 @@ package ais
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"errors"
 	"fmt"
@@ -13,7 +14,6 @@ import (
 	"net/http"
 	"net/url"
 	"os"
-	"sort"
 	"strconv"
 	"time"
 
@@ -49,6 +49,7 @@ func multiWriter(writers ...io.Writer) io.Writer {
 // Initialize multipart upload.
 // - Generate UUID for the upload
 // - Return the UUID to a caller
+// Optional "x-amz-checksum-algorithm" selects an additional per-part checksum (see s3.ParseCksumAlgo).
 // https://docs.aws.amazon.com/AmazonS3/latest/API/API_CreateMultipartUpload.html
 func (t *target) startMpt(w http.ResponseWriter, r *http.Request, items []string, bck *meta.Bck, q url.Values) {
 	var (
@@ -62,6 +63,11 @@ func (t *target) startMpt(w http.ResponseWriter, r *http.Request, items []string
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	ckty, err := s3.ParseCksumAlgo(r.Header.Get(cos.S3HdrCksumAlgo))
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
 	if bck.IsRemoteS3() {
 		pts := s3.NewPresignedReq(r, lom, nil, q)
 		resp, err := pts.Do(g.client.control)
@@ -76,7 +82,7 @@ func (t *target) startMpt(w http.ResponseWriter, r *http.Request, items []string
 				return
 			}
 
-			s3.InitUpload(result.UploadID, result.Bucket, result.Key)
+			s3.InitUpload(result.UploadID, lom, ckty)
 			w.Header().Set(cos.HdrContentType, cos.ContentXML)
 			w.Write(resp.Body)
 			return
@@ -90,11 +96,14 @@ func (t *target) startMpt(w http.ResponseWriter, r *http.Request, items []string
 		uploadID = cos.GenUUID()
 	}
 
-	s3.InitUpload(uploadID, bck.Name, objName)
+	s3.InitUpload(uploadID, lom, ckty)
 	result := &s3.InitiateMptUploadResult{Bucket: bck.Name, Key: objName, UploadID: uploadID}
 
 	sgl := t.gmm.NewSGL(0)
 	result.MustMarshal(sgl)
+	if algo, _ := s3.CksumAlgo(ckty); algo != "" {
+		w.Header().Set(cos.S3HdrCksumAlgo, algo)
+	}
 	w.Header().Set(cos.HdrContentType, cos.ContentXML)
 	sgl.WriteTo(w)
 	sgl.Free()
@@ -103,83 +112,244 @@ func (t *target) startMpt(w http.ResponseWriter, r *http.Request, items []string
 // PUT a part of the multipart upload.
 // Body is empty, everything in the query params and the header.
 //
-// "Content-MD5" in the part headers seems be to be deprecated:
-// either not present (s3cmd) or cannot be trusted (aws s3api).
+// "Content-MD5" (base64) is optional: not present with s3cmd but sent by boto3 and aws cli;
+// when present, the part is rejected with "BadDigest" on mismatch.
+// Same for "x-amz-checksum-*" (e.g., "x-amz-checksum-sha256") - must match the algorithm
+// negotiated by CreateMultipartUpload; the computed one is returned in the same header.
 //
 // https://docs.aws.amazon.com/AmazonS3/latest/API/API_UploadPart.html
 func (t *target) putMptPart(w http.ResponseWriter, r *http.Request, items []string, q url.Values, bck *meta.Bck) {
 	// 1. parse/validate
-	uploadID := q.Get(s3.QparamMptUploadID)
-	if uploadID == "" {
-		s3.WriteErr(w, r, errors.New("empty uploadId"), 0)
+	uploadID, partNum, err := parseMptPart(q)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
 		return
 	}
-	part := q.Get(s3.QparamMptPartNo)
-	if part == "" {
-		s3.WriteErr(w, r, fmt.Errorf("upload %q: missing part number", uploadID), 0)
+
+	// 2. init lom
+	objName := s3.ObjName(items)
+	lom := &core.LOM{ObjName: objName}
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		s3.WriteErr(w, r, err, 0)
 		return
 	}
-	partNum, err := s3.ParsePartNum(part)
+
+	// 3. write and add
+	var (
+		partMD5 string
+		partSHA = r.Header.Get(cos.S3HdrContentSHA256)
+		pts     = s3.NewPresignedReq(r, lom, nil, q)
+		xcksum  *cos.Cksum
+	)
+	if partSHA == cos.S3UnsignedPayload {
+		partSHA = ""
+	}
+	if hdr := r.Header.Get(cos.S3HdrContentMD5); hdr != "" {
+		if partMD5, err = s3.DecodeContentMD5(hdr); err != nil {
+			s3.WriteErr(w, r, err, 0)
+			return
+		}
+	}
+	if ckty := s3.CksumType(uploadID); ckty != "" {
+		val, err := s3.PartCksumHdr(r.Header, ckty)
+		if err != nil {
+			s3.WriteErr(w, r, err, 0)
+			return
+		}
+		xcksum = cos.NewCksum(ckty, val)
+	}
+	md5, xval, ecode, err := t._putMptPart(lom, uploadID, partNum, r.Body, partMD5, partSHA, xcksum, pts)
 	if err != nil {
-		s3.WriteErr(w, r, err, 0)
+		s3.WriteMptErr(w, r, err, ecode, lom, uploadID)
 		return
 	}
-	if partNum < 1 || partNum > s3.MaxPartsPerUpload {
-		err := fmt.Errorf("upload %q: invalid part number %d, must be between 1 and %d",
-			uploadID, partNum, s3.MaxPartsPerUpload)
+	w.Header().Set(cos.S3CksumHeader, md5) // s3cmd checks this one
+	if xcksum != nil {
+		_, hdr := s3.CksumAlgo(xcksum.Type())
+		w.Header().Set(hdr, xval)
+	}
+}
+
+// Copy an existing object (or its range) => part of the specified multipart upload.
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_UploadPartCopy.html
+func (t *target) putMptPartCopy(w http.ResponseWriter, r *http.Request, items []string, q url.Values, bck *meta.Bck) {
+	// 1. parse/validate
+	uploadID, partNum, err := parseMptPart(q)
+	if err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
-
-	// 2. init lom, create part file
 	objName := s3.ObjName(items)
 	lom := &core.LOM{ObjName: objName}
 	if err := lom.InitBck(bck.Bucket()); err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+
+	// 2. source (cold GET if need be)
+	srcLOM, err, ecode := t.initS3Src(r)
+	if err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	defer core.FreeLOM(srcLOM)
+
+	srcLOM.Lock(false)
+	err = srcLOM.Load(true /*cache it*/, true /*locked*/)
+	if cos.IsNotExist(err, 0) && srcLOM.Bck().IsRemote() {
+		srcLOM.Unlock(false)
+		if ecode, err = t.GetCold(context.Background(), srcLOM, cmn.OwtGetLock); err == nil {
+			srcLOM.Lock(false)
+			err = srcLOM.Load(true, true)
+			if err != nil {
+				srcLOM.Unlock(false)
+			}
+		}
+	} else if err != nil {
+		srcLOM.Unlock(false)
+	}
+	if err != nil {
+		if cos.IsNotExist(err, ecode) {
+			ecode = http.StatusNotFound
+		}
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+
+	// 3. range
+	var (
+		off, size = int64(0), srcLOM.SizeBytes()
+		lmtime    = srcLOM.Atime()
+	)
+	if rangeHdr := r.Header.Get(cos.S3HdrObjSrcRange); rangeHdr != "" {
+		ranges, errR := parseMultiRange(rangeHdr, size)
+		if errR == nil && len(ranges) != 1 {
+			errR = fmt.Errorf("invalid %s %q (expecting a single range)", cos.S3HdrObjSrcRange, rangeHdr)
+		}
+		if errR != nil {
+			srcLOM.Unlock(false)
+			s3.WriteErr(w, r, errR, http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+		off, size = ranges[0].Start, ranges[0].Length
+	}
+
+	// 4. write and add
+	fh, err := os.Open(srcLOM.FQN)
+	if err != nil {
+		srcLOM.Unlock(false)
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	md5, _, ecode, err := t._putMptPart(lom, uploadID, partNum, io.NewSectionReader(fh, off, size), "", "", nil, nil)
+	cos.Close(fh)
+	srcLOM.Unlock(false)
+	if err != nil {
+		s3.WriteMptErr(w, r, err, ecode, lom, uploadID)
+		return
+	}
+
+	// 5. respond
+	result := &s3.CopyPartResult{
+		LastModified: cos.FormatTime(lmtime, cos.ISO8601),
+		ETag:         md5,
+	}
+	sgl := t.gmm.NewSGL(0)
+	result.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo(w)
+	sgl.Free()
+}
+
+func parseMptPart(q url.Values) (uploadID string, partNum int32, err error) {
+	uploadID = q.Get(s3.QparamMptUploadID)
+	if uploadID == "" {
+		return "", 0, errors.New("empty uploadId")
+	}
+	part := q.Get(s3.QparamMptPartNo)
+	if part == "" {
+		return "", 0, fmt.Errorf("upload %q: missing part number", uploadID)
+	}
+	if partNum, err = s3.ParsePartNum(part); err != nil {
+		return "", 0, err
+	}
+	if partNum < 1 || partNum > s3.MaxPartsPerUpload {
+		err = fmt.Errorf("upload %q: invalid part number %d, must be between 1 and %d",
+			uploadID, partNum, s3.MaxPartsPerUpload)
+		return "", 0, err
+	}
+	return uploadID, partNum, nil
+}
+
+// write part to a workfile (computing and validating checksums), upload it to remote s3 if need be,
+// and add to the upload; `pts` (presigned request) is nil when not forwarding the original request;
+// `xcksum` (optional): negotiated part checksum type and the specified (base64) value, if any -
+// the computed one is then returned as `xval`
+func (t *target) _putMptPart(lom *core.LOM, uploadID string, partNum int32, body io.Reader, partMD5, partSHA string,
+	xcksum *cos.Cksum, pts *s3.PresignedReq) (md5, xval string, ecode int, err error) {
 	// workfile name format: <upload-id>.<part-number>.<obj-name>
 	prefix := uploadID + "." + strconv.FormatInt(int64(partNum), 10)
 	wfqn := fs.CSM.Gen(lom, fs.WorkfileType, prefix)
 	partFh, errC := lom.CreateFileRW(wfqn)
 	if errC != nil {
-		s3.WriteMptErr(w, r, errC, 0, lom, uploadID)
-		return
+		return "", "", 0, errC
 	}
 
-	// 3. write
+	// write
 	var (
-		etag         string
-		ecode        int
-		partSHA      = r.Header.Get(cos.S3HdrContentSHA256)
-		checkPartSHA = partSHA != "" && partSHA != cos.S3UnsignedPayload
-		buf, slab    = t.gmm.Alloc()
-		cksumSHA     = &cos.CksumHash{}
-		cksumMD5     = &cos.CksumHash{}
-		remote       = bck.IsRemoteS3()
+		etag      string
+		buf, slab = t.gmm.Alloc()
+		remote    = lom.Bck().IsRemoteS3()
+		types     = make([]string, 0, 3)
 	)
-	if checkPartSHA {
-		cksumSHA = cos.NewCksumHash(cos.ChecksumSHA256)
+	if partSHA != "" {
+		types = append(types, cos.ChecksumSHA256)
 	}
-	if !remote {
-		cksumMD5 = cos.NewCksumHash(cos.ChecksumMD5)
+	if xcksum != nil {
+		types = append(types, xcksum.Type()) // (duplicates are skipped)
 	}
-	mw := multiWriter(cksumMD5.H, cksumSHA.H, partFh)
-	size, err := io.CopyBuffer(mw, r.Body, buf)
+	if !remote || partMD5 != "" {
+		types = append(types, cos.ChecksumMD5)
+	}
+	// all checksums in one pass
+	cksums := cos.NewMultiCksumHash(types...)
+	mw := io.MultiWriter(cksums, partFh)
+	size, err := io.CopyBuffer(mw, body, buf)
 	slab.Free(buf)
 
-	// 4. rewind and call s3 API
+	// validate (prior to sending anything remotely)
+	if err == nil {
+		cksums.Finalize()
+		if cksumMD5 := cksums.Get(cos.ChecksumMD5); cksumMD5 != nil {
+			md5 = cksumMD5.Value()
+			err = s3.CheckContentMD5(partMD5, md5)
+		}
+		if err == nil && partSHA != "" {
+			cksumSHA := cksums.Get(cos.ChecksumSHA256)
+			recvSHA := cos.NewCksum(cos.ChecksumSHA256, partSHA)
+			if !cksumSHA.Equal(recvSHA) {
+				detail := fmt.Sprintf("upload %q, %s, part %d", uploadID, lom, partNum)
+				err = cos.NewErrDataCksum(&cksumSHA.Cksum, recvSHA, detail)
+				ecode = http.StatusInternalServerError
+			}
+		}
+		if err == nil && xcksum != nil {
+			xval, err = s3.CheckPartCksum(cksums.Get(xcksum.Type()), xcksum.Value())
+		}
+	}
+
+	// rewind and call s3 API
 	if err == nil && remote {
 		if _, err = partFh.Seek(0, io.SeekStart); err == nil {
-			var (
-				resp *s3.PresignedResp
-				pts  = s3.NewPresignedReq(r, lom, partFh, q)
-			)
-			resp, err = pts.Do(g.client.data)
+			var resp *s3.PresignedResp
+			if pts != nil {
+				pts.SetBody(partFh)
+				resp, err = pts.Do(g.client.data)
+			}
 			if resp != nil {
 				ecode = resp.StatusCode
 				etag = cmn.UnquoteCEV(resp.Header.Get(cos.HdrETag))
-			} else {
+			} else if err == nil {
 				etag, ecode, err = backend.PutMptPart(lom, partFh, uploadID, partNum, size)
 			}
 		}
@@ -190,27 +360,13 @@ func (t *target) putMptPart(w http.ResponseWriter, r *http.Request, items []stri
 		if nerr := cos.RemoveFile(wfqn); nerr != nil && !os.IsNotExist(nerr) {
 			nlog.Errorf(fmtNested, t, err, "remove", wfqn, nerr)
 		}
-		s3.WriteMptErr(w, r, err, ecode, lom, uploadID)
-		return
+		return "", "", ecode, err
 	}
 
-	// 5. finalize part
+	// finalize part
 	// expecting the part's remote etag to be md5 checksum, not computing otherwise
-	md5 := etag
-	if cksumMD5.H != nil {
-		debug.Assert(etag == "")
-		cksumMD5.Finalize()
-		md5 = cksumMD5.Value()
-	}
-	if checkPartSHA {
-		cksumSHA.Finalize()
-		recvSHA := cos.NewCksum(cos.ChecksumSHA256, partSHA)
-		if !cksumSHA.Equal(recvSHA) {
-			detail := fmt.Sprintf("upload %q, %s, part %d", uploadID, lom, partNum)
-			err = cos.NewErrDataCksum(&cksumSHA.Cksum, recvSHA, detail)
-			s3.WriteMptErr(w, r, err, http.StatusInternalServerError, lom, uploadID)
-			return
-		}
+	if etag != "" {
+		md5 = etag
 	}
 	npart := &s3.MptPart{
 		MD5:  md5,
@@ -219,10 +375,13 @@ func (t *target) putMptPart(w http.ResponseWriter, r *http.Request, items []stri
 		Num:  partNum,
 	}
 	if err := s3.AddPart(uploadID, npart); err != nil {
-		s3.WriteMptErr(w, r, err, 0, lom, uploadID)
-		return
+		// e.g., aborted or expired while the part was being written
+		if nerr := cos.RemoveFile(wfqn); nerr != nil && !os.IsNotExist(nerr) {
+			nlog.Errorf(fmtNested, t, err, "remove", wfqn, nerr)
+		}
+		return "", "", 0, err
 	}
-	w.Header().Set(cos.S3CksumHeader, md5) // s3cmd checks this one
+	return md5, xval, 0, nil
 }
 
 // Complete multipart upload.
@@ -259,11 +418,17 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
-	size, errN := s3.ObjSize(uploadID)
-	if errN != nil {
-		s3.WriteMptErr(w, r, errN, 0, lom, uploadID)
+
+	// check parts (order, ETags, size limits) - prior to completing remotely
+	nparts, err := s3.CheckParts(uploadID, partList.Parts)
+	if err != nil {
+		s3.WriteMptErr(w, r, err, 0, lom, uploadID)
 		return
 	}
+	var size int64
+	for _, npart := range nparts {
+		size += npart.Size
+	}
 
 	// call s3
 	var (
@@ -296,22 +461,14 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 		}
 	}
 
-	// append parts and finalize locally
+	// merge parts into a new workfile and finalize locally;
+	// the parts themselves remain intact until cleanup, so that a failed completion can be retried
 	var (
 		mw          io.Writer
 		concatMD5   string // => ETag
 		actualCksum = &cos.CksumHash{}
 	)
-	// .1 sort and check parts
-	sort.Slice(partList.Parts, func(i, j int) bool {
-		return partList.Parts[i].PartNumber < partList.Parts[j].PartNumber
-	})
-	nparts, err := s3.CheckParts(uploadID, partList.Parts)
-	if err != nil {
-		s3.WriteMptErr(w, r, err, 0, lom, uploadID)
-		return
-	}
-	// 2. <upload-id>.complete.<obj-name>
+	// .1 <upload-id>.complete.<obj-name>
 	prefix := uploadID + ".complete"
 	wfqn := fs.CSM.Gen(lom, fs.WorkfileType, prefix)
 	wfh, errC := lom.CreateFile(wfqn)
@@ -326,7 +483,7 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 	}
 	mw = multiWriter(actualCksum.H, wfh)
 
-	// .3 write
+	// .2 write
 	buf, slab := t.gmm.Alloc()
 	concatMD5, written, errA := _appendMpt(nparts, buf, mw)
 	slab.Free(buf)
@@ -348,7 +505,7 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 		return
 	}
 
-	// .4 (s3 client => ais://) compute resulting MD5 and, optionally, ETag
+	// .3 (s3 client => ais://) compute resulting MD5 and, optionally, ETag
 	if actualCksum.H != nil {
 		actualCksum.Finalize()
 		lom.SetCksum(actualCksum.Cksum.Clone())
@@ -363,7 +520,7 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 		etag = resMD5.Value() + cmn.AwsMultipartDelim + strconv.Itoa(len(partList.Parts))
 	}
 
-	// .5 finalize
+	// .4 finalize (under wlock - see poi.fini); persist ETag as custom metadata (see s3.SetEtag)
 	lom.SetSize(size)
 	lom.SetCustomKey(cmn.ETag, etag)
 
@@ -378,20 +535,23 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 	ecode, errF := poi.finalize()
 	freePOI(poi)
 
-	// .6 cleanup parts - unconditionally
+	if errF != nil && !remote {
+		s3.WriteMptErr(w, r, errF, ecode, lom, uploadID) // (retriable)
+		return
+	}
+
+	// .5 cleanup parts and store upload state (xattr) - the latter under wlock (see getMptPart)
+	lom.Lock(true)
 	exists := s3.CleanupUpload(uploadID, lom.FQN, false /*aborted*/)
+	lom.Unlock(true)
 	debug.Assert(exists)
 
 	if errF != nil {
 		// NOTE: not failing if remote op. succeeded
-		if !remote {
-			s3.WriteMptErr(w, r, errF, ecode, lom, uploadID)
-			return
-		}
-		nlog.Errorf("upload %q: failed to complete %s locally: %v(%d)", uploadID, lom.Cname(), err, ecode)
+		nlog.Errorf("upload %q: failed to complete %s locally: %v(%d)", uploadID, lom.Cname(), errF, ecode)
 	}
 
-	// .7 respond
+	// .6 respond
 	result := &s3.CompleteMptUploadResult{Bucket: bck.Name, Key: objName, ETag: etag}
 	sgl := t.gmm.NewSGL(0)
 	result.MustMarshal(sgl)
@@ -473,6 +633,7 @@ func (t *target) abortMpt(w http.ResponseWriter, r *http.Request, items []string
 // (NOTE: `s3cmd` lists upload parts before checking if any parts can be skipped.)
 // s3cmd is OK to receive an empty body in response with status=200. In this
 // case s3cmd sends all parts.
+// Paginated via `max-parts` (default and max: 1000) and `part-number-marker`.
 // https://docs.aws.amazon.com/AmazonS3/latest/API/API_ListParts.html
 func (t *target) listMptParts(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, q url.Values) {
 	uploadID := q.Get(s3.QparamMptUploadID)
@@ -488,7 +649,24 @@ func (t *target) listMptParts(w http.ResponseWriter, r *http.Request, bck *meta.
 		s3.WriteErr(w, r, err, ecode)
 		return
 	}
+	var (
+		maxParts int
+		marker   int32
+	)
+	if s := q.Get(s3.QparamMptMaxParts); s != "" {
+		if maxParts, err = strconv.Atoi(s); err != nil || maxParts < 0 {
+			s3.WriteErr(w, r, fmt.Errorf("invalid %s %q", s3.QparamMptMaxParts, s), 0)
+			return
+		}
+	}
+	if s := q.Get(s3.QparamMptPartNoMarker); s != "" {
+		if marker, err = s3.ParsePartNum(s); err != nil {
+			s3.WriteErr(w, r, err, 0)
+			return
+		}
+	}
 	result := &s3.ListPartsResult{Bucket: bck.Name, Key: objName, UploadID: uploadID, Parts: parts}
+	result.Paginate(marker, maxParts)
 	sgl := t.gmm.NewSGL(0)
 	result.MustMarshal(sgl)
 	w.Header().Set(cos.HdrContentType, cos.ContentXML)
@@ -501,17 +679,7 @@ func (t *target) listMptParts(w http.ResponseWriter, r *http.Request, bck *meta.
 // GET /?uploads&delimiter=Delimiter&encoding-type=EncodingType&key-marker=KeyMarker&
 // max-uploads=MaxUploads&prefix=Prefix&upload-id-marker=UploadIdMarker
 func (t *target) listMptUploads(w http.ResponseWriter, bck *meta.Bck, q url.Values) {
-	var (
-		maxUploads int
-		idMarker   string
-	)
-	if s := q.Get(s3.QparamMptMaxUploads); s != "" {
-		if v, err := strconv.Atoi(s); err == nil {
-			maxUploads = v
-		}
-	}
-	idMarker = q.Get(s3.QparamMptUploadIDMarker)
-	result := s3.ListUploads(bck.Name, idMarker, maxUploads)
+	result := s3.ListUploads(bck.Name, s3.NewListMptUploadsArgs(q))
 	sgl := t.gmm.NewSGL(0)
 	result.MustMarshal(sgl)
 	w.Header().Set(cos.HdrContentType, cos.ContentXML)
@@ -522,6 +690,8 @@ func (t *target) listMptUploads(w http.ResponseWriter, bck *meta.Bck, q url.Valu
 // Acts on an already multipart-uploaded object, returns `partNumber` (URL query)
 // part of the object.
 // The object must have been multipart-uploaded beforehand.
+// Honors conditional headers ("If-Match", "If-None-Match", etc. - see s3.CheckPreconditions)
+// and "Range" (within the part: 206 Partial Content or 416 Range Not Satisfiable).
 // See:
 // https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObject.html
 func (t *target) getMptPart(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, q url.Values) {
@@ -536,16 +706,68 @@ func (t *target) getMptPart(w http.ResponseWriter, r *http.Request, bck *meta.Bc
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	lom.Lock(false)
+	defer lom.Unlock(false)
+
+	// conditional GET (compare with HEAD: same ETag and Last-Modified)
+	if err := lom.Load(true /*cache it*/, true /*locked*/); err != nil {
+		ecode := 0
+		if cos.IsNotExist(err, 0) {
+			ecode = http.StatusNotFound
+		}
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	hdr := w.Header()
+	s3.SetEtag(hdr, lom)
+	hdr.Set(cos.S3LastModified, cos.FormatTime(lom.Atime(), cos.RFC1123GMT))
+	if status, err := s3.CheckPreconditions(r.Header, hdr.Get(cos.S3CksumHeader), lom.Atime()); status != http.StatusOK {
+		if err != nil {
+			s3.WriteErr(w, r, err, status)
+		} else {
+			w.WriteHeader(status)
+		}
+		return
+	}
+
 	// load mpt xattr and find out the part num's offset & size
 	off, size, status, err := s3.OffsetSorted(lom, partNum)
 	if err != nil {
 		s3.WriteErr(w, r, err, status)
+		return
 	}
+
+	// optional range - relative to the part (compare w/ getOI.parseRange)
+	status = http.StatusOK
+	hdr.Set(cos.HdrAcceptRanges, "bytes")
+	if rangeHdr := r.Header.Get(cos.HdrRange); rangeHdr != "" {
+		ranges, err := parseMultiRange(rangeHdr, size)
+		if err == nil && len(ranges) > 1 {
+			err = cmn.NewErrUnsupp("multi-range read", lom.Cname())
+		}
+		if err != nil {
+			if cmn.IsErrRangeNotSatisfiable(err) {
+				hdr.Set(cos.HdrContentRange, fmt.Sprintf("%s*/%d", cos.HdrContentRangeValPrefix, size))
+			}
+			s3.WriteErr(w, r, err, http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+		if len(ranges) == 1 {
+			hrng := &ranges[0]
+			hdr.Set(cos.HdrContentRange, hrng.contentRange(size))
+			off += hrng.Start
+			size = hrng.Length
+			status = http.StatusPartialContent
+		}
+	}
+
 	fh, err := os.Open(lom.FQN)
 	if err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	hdr.Set(cos.HdrContentLength, strconv.FormatInt(size, 10))
+	w.WriteHeader(status)
 	buf, slab := t.gmm.AllocSize(size)
 	reader := io.NewSectionReader(fh, off, size)
 	if _, err := io.CopyBuffer(w, reader, buf); err != nil {
@@ -0,0 +1,428 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/readers"
+)
+
+// failed completion must leave the parts intact, and the retry must produce the same object
+// (`t` is the target - see TestMain)
+func TestCompleteMptRetry(tt *testing.T) {
+	const id = "test-complete-retry"
+	var (
+		bck   = meta.NewBck(testBucket, apc.AIS, cmn.NsGlobal)
+		items = []string{testBucket, "mpt", "obj"}
+	)
+	lom, whole, complete := mptUpload(tt, id, bck, items, 2)
+
+	// 1st attempt: fail to finalize (destination is a non-empty directory)
+	if err := cos.CreateDir(filepath.Join(lom.FQN, "block")); err != nil {
+		tt.Fatal(err)
+	}
+	if w := complete(); w.Code == http.StatusOK {
+		tt.Fatal("expected completion to fail")
+	}
+	uploaded, _, err := s3.ListParts(id, lom)
+	if err != nil || len(uploaded) != 2 {
+		tt.Fatalf("expected %d parts to remain, got %d (err: %v)", 2, len(uploaded), err)
+	}
+
+	// 2nd attempt
+	if err := os.RemoveAll(lom.FQN); err != nil {
+		tt.Fatal(err)
+	}
+	if w := complete(); w.Code != http.StatusOK {
+		tt.Fatalf("retry: %d %s", w.Code, w.Body.String())
+	}
+	b, err := os.ReadFile(lom.FQN)
+	if err != nil {
+		tt.Fatal(err)
+	}
+	if !bytes.Equal(b, whole) {
+		tt.Fatalf("object content differs from the concatenated parts (%d vs %d bytes)", len(b), len(whole))
+	}
+	if res := s3.ListUploads(testBucket, &s3.ListMptUploadsArgs{}); len(res.Uploads) != 0 {
+		tt.Fatalf("expected upload %q to be cleaned up, got %+v", id, res.Uploads)
+	}
+}
+
+// concurrent readers must never observe a partially written object;
+// the multipart ETag must be persisted and returned by HEAD and GET
+func TestCompleteMptConcurrentGet(tt *testing.T) {
+	const id = "test-complete-concurrent"
+	var (
+		bck   = meta.NewBck(testBucket, apc.AIS, cmn.NsGlobal)
+		items = []string{testBucket, "mpt", "obj-concurrent"}
+		done  atomic.Bool
+		wg    sync.WaitGroup
+		nread atomic.Int64
+		errCh = make(chan error, 1)
+	)
+	lom, whole, complete := mptUpload(tt, id, bck, items, 2)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for !done.Load() {
+			rlom := core.AllocLOM(lom.ObjName)
+			if err := rlom.InitBck(bck.Bucket()); err != nil {
+				errCh <- err
+				return
+			}
+			rlom.Lock(false)
+			b, err := os.ReadFile(rlom.FQN)
+			rlom.Unlock(false)
+			core.FreeLOM(rlom)
+			switch {
+			case os.IsNotExist(err):
+			case err != nil:
+				errCh <- err
+				return
+			case !bytes.Equal(b, whole):
+				errCh <- fmt.Errorf("partial object: %d bytes (expected %d)", len(b), len(whole))
+				return
+			default:
+				nread.Inc()
+			}
+		}
+	}()
+	w := complete()
+	for nread.Load() == 0 && len(errCh) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	done.Store(true)
+	wg.Wait()
+	if len(errCh) > 0 {
+		tt.Fatal(<-errCh)
+	}
+	if w.Code != http.StatusOK {
+		tt.Fatalf("complete: %d %s", w.Code, w.Body.String())
+	}
+	etag := w.Header().Get(cos.S3CksumHeader)
+	if !cmn.IsS3MultipartEtag(etag) {
+		tt.Fatalf("expected multipart ETag, got %q", etag)
+	}
+
+	// HEAD and GET (part)
+	wh := httptest.NewRecorder()
+	t.headObjS3(wh, httptest.NewRequest(http.MethodHead, "/s3/x", http.NoBody), items)
+	if v := wh.Header().Get(cos.S3CksumHeader); v != etag {
+		tt.Fatalf("HEAD: expected ETag %q, got %q", etag, v)
+	}
+	glom := &core.LOM{ObjName: lom.ObjName}
+	if err := glom.InitBck(bck.Bucket()); err != nil {
+		tt.Fatal(err)
+	}
+	if err := glom.Load(false, false); err != nil {
+		tt.Fatal(err)
+	}
+	hdr := make(http.Header)
+	s3.SetEtag(hdr, glom)
+	if v := hdr.Get(cos.S3CksumHeader); v != etag {
+		tt.Fatalf("GET: expected ETag %q, got %q", etag, v)
+	}
+	q := url.Values{s3.QparamMptPartNo: []string{"2"}}
+	wg2 := httptest.NewRecorder()
+	t.getMptPart(wg2, httptest.NewRequest(http.MethodGet, "/s3/x?"+q.Encode(), http.NoBody), bck, lom.ObjName, q)
+	if !bytes.Equal(wg2.Body.Bytes(), whole[s3.MinPartSize:]) {
+		tt.Fatalf("GET part 2: unexpected content %q", wg2.Body.String())
+	}
+}
+
+// conditional GET of a part: 304 Not Modified and 412 Precondition Failed short-circuit the read
+func TestGetMptPartConditional(tt *testing.T) {
+	const id = "test-get-part-conditional"
+	var (
+		bck   = meta.NewBck(testBucket, apc.AIS, cmn.NsGlobal)
+		items = []string{testBucket, "mpt", "obj-conditional"}
+	)
+	lom, whole, complete := mptUpload(tt, id, bck, items, 2)
+	if w := complete(); w.Code != http.StatusOK {
+		tt.Fatalf("complete: %d %s", w.Code, w.Body.String())
+	}
+	get := func(hdrs map[string]string) *httptest.ResponseRecorder {
+		q := url.Values{s3.QparamMptPartNo: []string{"2"}}
+		w, r := httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/s3/x?"+q.Encode(), http.NoBody)
+		for k, v := range hdrs {
+			r.Header.Set(k, v)
+		}
+		t.getMptPart(w, r, bck, lom.ObjName, q)
+		return w
+	}
+
+	w := get(nil)
+	etag, lastModified := w.Header().Get(cos.HdrETag), w.Header().Get(cos.S3LastModified)
+	if w.Code != http.StatusOK || etag == "" || lastModified == "" {
+		tt.Fatalf("expected 200 with ETag and Last-Modified, got %d %q %q", w.Code, etag, lastModified)
+	}
+	if !bytes.Equal(w.Body.Bytes(), whole[s3.MinPartSize:]) {
+		tt.Fatalf("unexpected content %q", w.Body.String())
+	}
+	tests := []struct {
+		hdrs   map[string]string
+		status int
+	}{
+		{map[string]string{cos.HdrIfMatch: `"` + etag + `"`}, http.StatusOK},
+		{map[string]string{cos.HdrIfMatch: `"other"`}, http.StatusPreconditionFailed},
+		{map[string]string{cos.HdrIfNoneMatch: `"` + etag + `"`}, http.StatusNotModified},
+		{map[string]string{cos.HdrIfNoneMatch: `"other"`}, http.StatusOK},
+		{map[string]string{cos.HdrIfModifiedSince: lastModified}, http.StatusNotModified},
+		{map[string]string{cos.HdrIfUnmodifiedSince: lastModified}, http.StatusOK},
+		{map[string]string{cos.HdrIfUnmodifiedSince: "Mon, 02 Jan 2006 15:04:05 GMT"}, http.StatusPreconditionFailed},
+	}
+	for _, test := range tests {
+		w := get(test.hdrs)
+		if w.Code != test.status {
+			tt.Fatalf("%v: expected %d, got %d %s", test.hdrs, test.status, w.Code, w.Body.String())
+		}
+		if test.status != http.StatusOK && bytes.Contains(w.Body.Bytes(), whole[s3.MinPartSize:]) {
+			tt.Fatalf("%v: unexpected content with status %d", test.hdrs, w.Code)
+		}
+	}
+}
+
+// HEAD reports the number of parts (and the multipart ETag) - for multipart-uploaded objects only
+func TestHeadMptPartsCount(tt *testing.T) {
+	const id = "test-head-parts-count"
+	var (
+		bck   = meta.NewBck(testBucket, apc.AIS, cmn.NsGlobal)
+		items = []string{testBucket, "mpt", "obj-head"}
+	)
+	_, _, complete := mptUpload(tt, id, bck, items, 3)
+	w := complete()
+	if w.Code != http.StatusOK {
+		tt.Fatalf("complete: %d %s", w.Code, w.Body.String())
+	}
+	etag := w.Header().Get(cos.S3CksumHeader)
+
+	wh := httptest.NewRecorder()
+	t.headObjS3(wh, httptest.NewRequest(http.MethodHead, "/s3/x", http.NoBody), items)
+	if v := wh.Header().Get(cos.S3HdrMptCnt); v != "3" {
+		tt.Fatalf("expected %s %q, got %q", cos.S3HdrMptCnt, "3", v)
+	}
+	if v := wh.Header().Get(cos.S3CksumHeader); v != etag || !strings.HasSuffix(v, "-3") {
+		tt.Fatalf("expected multipart ETag %q, got %q", etag, v)
+	}
+
+	// regular PUT
+	items = []string{testBucket, "mpt", "obj-head-regular"}
+	lom := &core.LOM{ObjName: s3.ObjName(items)}
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		tt.Fatal(err)
+	}
+	r, _ := readers.NewRand(cos.KiB, cos.ChecksumNone)
+	poi := &putOI{
+		atime:   time.Now().UnixNano(),
+		t:       t,
+		lom:     lom,
+		r:       r,
+		workFQN: fs.CSM.Gen(lom, fs.WorkfileType, "head-regular"),
+		config:  cmn.GCO.Get(),
+	}
+	if _, err := poi.putObject(); err != nil {
+		tt.Fatal(err)
+	}
+	wh = httptest.NewRecorder()
+	t.headObjS3(wh, httptest.NewRequest(http.MethodHead, "/s3/x", http.NoBody), items)
+	if wh.Code != http.StatusOK {
+		tt.Fatalf("HEAD: %d %s", wh.Code, wh.Body.String())
+	}
+	if v := wh.Header().Get(cos.S3HdrMptCnt); v != "" {
+		tt.Fatalf("expected no %s, got %q", cos.S3HdrMptCnt, v)
+	}
+}
+
+// range read within a part: 206 Partial Content (clamped to the part) or 416
+func TestGetMptPartRange(tt *testing.T) {
+	const id = "test-get-part-range"
+	var (
+		bck   = meta.NewBck(testBucket, apc.AIS, cmn.NsGlobal)
+		items = []string{testBucket, "mpt", "obj-range"}
+	)
+	lom, whole, complete := mptUpload(tt, id, bck, items, 2)
+	if w := complete(); w.Code != http.StatusOK {
+		tt.Fatalf("complete: %d %s", w.Code, w.Body.String())
+	}
+	var (
+		part1 = whole[:s3.MinPartSize]
+		part2 = whole[s3.MinPartSize:]
+	)
+	get := func(num, rng string) *httptest.ResponseRecorder {
+		q := url.Values{s3.QparamMptPartNo: []string{num}}
+		w, r := httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/s3/x?"+q.Encode(), http.NoBody)
+		if rng != "" {
+			r.Header.Set(cos.HdrRange, rng)
+		}
+		t.getMptPart(w, r, bck, lom.ObjName, q)
+		return w
+	}
+	tests := []struct {
+		num, rng     string
+		status       int
+		content      []byte
+		contentRange string
+	}{
+		{"2", "", http.StatusOK, part2, ""},
+		{"1", "bytes=100-199", http.StatusPartialContent, part1[100:200], fmt.Sprintf("bytes 100-199/%d", len(part1))},
+		{"2", "bytes=4-", http.StatusPartialContent, part2[4:], fmt.Sprintf("bytes 4-%d/%d", len(part2)-1, len(part2))},
+		{"2", "bytes=-4", http.StatusPartialContent, part2[len(part2)-4:],
+			fmt.Sprintf("bytes %d-%d/%d", len(part2)-4, len(part2)-1, len(part2))},
+		{"2", "bytes=2-1000000", http.StatusPartialContent, part2[2:], fmt.Sprintf("bytes 2-%d/%d", len(part2)-1, len(part2))},
+		{"2", fmt.Sprintf("bytes=%d-", len(part2)), http.StatusRequestedRangeNotSatisfiable, nil,
+			fmt.Sprintf("bytes */%d", len(part2))},
+		{"2", "bytes=0-1,4-5", http.StatusRequestedRangeNotSatisfiable, nil, ""},
+	}
+	for _, test := range tests {
+		w := get(test.num, test.rng)
+		if w.Code != test.status {
+			tt.Fatalf("part %s, %q: expected %d, got %d %s", test.num, test.rng, test.status, w.Code, w.Body.String())
+		}
+		if v := w.Header().Get(cos.HdrContentRange); v != test.contentRange {
+			tt.Fatalf("part %s, %q: expected Content-Range %q, got %q", test.num, test.rng, test.contentRange, v)
+		}
+		if test.content != nil && !bytes.Equal(w.Body.Bytes(), test.content) {
+			tt.Fatalf("part %s, %q: unexpected content (%d bytes)", test.num, test.rng, w.Body.Len())
+		}
+	}
+}
+
+// SHA-256 negotiated via CreateMultipartUpload: parts are verified, and the computed checksum is returned
+func TestPutMptPartSHA256(tt *testing.T) {
+	var (
+		bck   = meta.NewBck(testBucket, apc.AIS, cmn.NsGlobal)
+		items = []string{testBucket, "mpt", "obj-sha256"}
+		data  = []byte("part checksummed with SHA-256")
+		sum   = sha256.Sum256(data)
+		good  = base64.StdEncoding.EncodeToString(sum[:])
+	)
+	if err := bck.Init(t.owner.bmd); err != nil {
+		tt.Fatal(err)
+	}
+
+	// unsupported algorithm
+	w, r := httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/s3/x?uploads", http.NoBody)
+	r.Header.Set(cos.S3HdrCksumAlgo, "SHA1")
+	t.startMpt(w, r, items, bck, r.URL.Query())
+	if w.Code != http.StatusBadRequest {
+		tt.Fatalf("expected %d, got %d", http.StatusBadRequest, w.Code)
+	}
+
+	w, r = httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/s3/x?uploads", http.NoBody)
+	r.Header.Set(cos.S3HdrCksumAlgo, "sha256")
+	t.startMpt(w, r, items, bck, r.URL.Query())
+	if w.Code != http.StatusOK || w.Header().Get(cos.S3HdrCksumAlgo) != "SHA256" {
+		tt.Fatalf("start: %d %q", w.Code, w.Header().Get(cos.S3HdrCksumAlgo))
+	}
+	result := &s3.InitiateMptUploadResult{}
+	if err := xml.Unmarshal(w.Body.Bytes(), result); err != nil {
+		tt.Fatal(err)
+	}
+	id := result.UploadID
+	defer s3.CleanupUpload(id, "", true /*aborted*/)
+
+	put := func(num, cksum string) *httptest.ResponseRecorder {
+		q := url.Values{s3.QparamMptUploadID: []string{id}, s3.QparamMptPartNo: []string{num}}
+		w, r := httptest.NewRecorder(), httptest.NewRequest(http.MethodPut, "/s3/x?"+q.Encode(), bytes.NewReader(data))
+		if cksum != "" {
+			r.Header.Set(cos.S3ChecksumSHA256, cksum)
+		}
+		t.putMptPart(w, r, items, q, bck)
+		return w
+	}
+	// match (and no checksum specified - computed anyway)
+	for num, cksum := range map[string]string{"1": good, "2": ""} {
+		w := put(num, cksum)
+		if w.Code != http.StatusOK {
+			tt.Fatalf("part %s: %d %s", num, w.Code, w.Body.String())
+		}
+		if v := w.Header().Get(cos.S3ChecksumSHA256); v != good {
+			tt.Fatalf("part %s: expected %s %q, got %q", num, cos.S3ChecksumSHA256, good, v)
+		}
+	}
+	// mismatch
+	bad := base64.StdEncoding.EncodeToString(make([]byte, sha256.Size))
+	if w := put("3", bad); w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "<Code>BadDigest</Code>") {
+		tt.Fatalf("expected %d BadDigest, got %d %q", http.StatusBadRequest, w.Code, w.Body.String())
+	}
+	lom := &core.LOM{ObjName: s3.ObjName(items)}
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		tt.Fatal(err)
+	}
+	if parts, _, err := s3.ListParts(id, lom); err != nil || len(parts) != 2 {
+		tt.Fatalf("expected %d parts, got %d (err: %v)", 2, len(parts), err)
+	}
+}
+
+// upload `nparts` parts: MinPartSize (random) each except the last (small) one
+func mptUpload(tt *testing.T, id string, bck *meta.Bck, items []string, nparts int) (lom *core.LOM, whole []byte,
+	complete func() *httptest.ResponseRecorder) {
+	var (
+		parts = make([][]byte, 0, nparts)
+		body  = &s3.CompleteMptUpload{}
+	)
+	for range nparts - 1 {
+		part := make([]byte, s3.MinPartSize)
+		rand.Read(part)
+		parts = append(parts, part)
+	}
+	parts = append(parts, []byte("the last part"))
+	if err := bck.Init(t.owner.bmd); err != nil {
+		tt.Fatal(err)
+	}
+	lom = &core.LOM{ObjName: s3.ObjName(items)}
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		tt.Fatal(err)
+	}
+	s3.InitUpload(id, lom, "")
+
+	for i, data := range parts {
+		num := strconv.Itoa(i + 1)
+		q := url.Values{s3.QparamMptUploadID: []string{id}, s3.QparamMptPartNo: []string{num}}
+		w, r := httptest.NewRecorder(), httptest.NewRequest(http.MethodPut, "/s3/x?"+q.Encode(), bytes.NewReader(data))
+		t.putMptPart(w, r, items, q, bck)
+		if w.Code != http.StatusOK {
+			tt.Fatalf("part %s: %d %s", num, w.Code, w.Body.String())
+		}
+		whole = append(whole, data...)
+		body.Parts = append(body.Parts, &s3.PartInfo{PartNumber: int32(i + 1)})
+	}
+	xbody, err := xml.Marshal(body)
+	if err != nil {
+		tt.Fatal(err)
+	}
+	complete = func() *httptest.ResponseRecorder {
+		q := url.Values{s3.QparamMptUploadID: []string{id}}
+		w, r := httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/s3/x?"+q.Encode(), bytes.NewReader(xbody))
+		t.completeMpt(w, r, items, q, bck)
+		return w
+	}
+	return lom, whole, complete
+}
@@ -8,6 +8,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/NVIDIA/aistore/ais/s3"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/atomic"
@@ -17,6 +18,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/ios"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/space"
@@ -139,3 +141,34 @@ func (t *target) runStoreCleanup(id string, wg *sync.WaitGroup, bcks ...cmn.Bck)
 	})
 	return space.RunCleanup(&ini)
 }
+
+// expire inactive multipart uploads (if any)
+func (t *target) housekeepMpt() time.Duration {
+	if len(s3.StaleUploads(s3.UploadTTL(cmn.GCO.Get()))) > 0 {
+		go t.runMptCleanup("")
+	}
+	return hk.DelOldIval
+}
+
+func (t *target) runMptCleanup(id string) {
+	regToIC := id == ""
+	if regToIC {
+		id = cos.GenUUID()
+	}
+	rns := xreg.RenewMptCleanup(id)
+	if rns.Err != nil || rns.IsRunning() {
+		debug.Assert(rns.Err == nil || cmn.IsErrXactUsePrev(rns.Err))
+		return
+	}
+	xctn := rns.Entry.Get()
+	if regToIC && xctn.ID() == id {
+		regMsg := xactRegMsg{UUID: id, Kind: apc.ActMptCleanup, Srcs: []string{t.SID()}}
+		msg := t.newAmsgActVal(apc.ActRegGlobalXaction, regMsg)
+		t.bcastAsyncIC(msg)
+	}
+	xctn.AddNotif(&xact.NotifXact{
+		Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+		Xact: xctn,
+	})
+	s3.RunMptCleanup(xctn.(*s3.XactMptCleanup), s3.UploadTTL(cmn.GCO.Get()))
+}
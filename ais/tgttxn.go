@@ -112,6 +112,10 @@ func (t *target) txnHandler(w http.ResponseWriter, r *http.Request) {
 			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, c.msg.Value, err)
 			return
 		}
+		if err := tcbmsg.InitRename(); err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
 		if msg.Action == apc.ActETLBck {
 			var err error
 			if dp, err = etlDP(tcbmsg); err != nil {
@@ -129,6 +133,10 @@ func (t *target) txnHandler(w http.ResponseWriter, r *http.Request) {
 			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, c.msg.Value, err)
 			return
 		}
+		if err := tcomsg.InitRename(); err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
 		if msg.Action == apc.ActETLObjects {
 			cs := fs.Cap()
 			if err := cs.Err(); err != nil {
@@ -264,7 +272,8 @@ func (t *target) makeNCopies(c *txnSrv) (string, error) {
 		}
 
 		// do the work in xaction
-		rns := xreg.RenewBckMakeNCopies(c.bck, c.uuid, "mnc-actmnc", int(copies))
+		repair := c.msg.Name == apc.NameRepairNC
+		rns := xreg.RenewBckMakeNCopies(c.bck, c.uuid, "mnc-actmnc", int(copies), repair)
 		if rns.Err != nil {
 			return "", fmt.Errorf("%s %s: %v", t, txn, rns.Err)
 		}
@@ -346,7 +355,7 @@ func (t *target) setBprops(c *txnSrv) (string, error) {
 		}
 		if _reMirror(bprops, nprops) {
 			n := int(nprops.Mirror.Copies)
-			rns := xreg.RenewBckMakeNCopies(c.bck, c.uuid, "mnc-setprops", n)
+			rns := xreg.RenewBckMakeNCopies(c.bck, c.uuid, "mnc-setprops", n, false /*repair*/)
 			if rns.Err != nil {
 				return "", fmt.Errorf("%s %s: %v", t, txn, rns.Err)
 			}
@@ -661,7 +670,7 @@ func (t *target) tcobjs(c *txnSrv, msg *cmn.TCObjsMsg, dp core.DP) (xid string,
 			return xid, cmn.NewErrBckNotFound(bckFrom.Bucket())
 		}
 		// begin
-		custom := &xreg.TCObjsArgs{BckFrom: bckFrom, BckTo: bckTo, DP: dp}
+		custom := &xreg.TCObjsArgs{BckFrom: bckFrom, BckTo: bckTo, DP: dp, WindowSize: msg.WindowSize, BwLimit: msg.BwLimit}
 		rns := xreg.RenewTCObjs(c.msg.Action /*kind*/, custom)
 		if rns.Err != nil {
 			nlog.Errorf("%s: %q %+v %v", t, c.uuid, c.msg, rns.Err)
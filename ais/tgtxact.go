@@ -73,6 +73,10 @@ func (t *target) httpxget(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if what == apc.WhatXactErrNames {
+		t.xerrNames(w, r, what, xactMsg.ID)
+		return
+	}
 	if what != apc.WhatQueryXactStats {
 		t.writeErrf(w, r, fmtUnknownQue, what)
 		return
@@ -167,6 +171,26 @@ func (t *target) xget(w http.ResponseWriter, r *http.Request, what, uuid string)
 	t.writeErr(w, r, err, http.StatusNotFound, Silent)
 }
 
+// full list of failed-to-copy names (compare with xs.ExtTCStats that carries only a few)
+func (t *target) xerrNames(w http.ResponseWriter, r *http.Request, what, uuid string) {
+	xctn, err := xreg.GetXact(uuid)
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	if xctn == nil {
+		err = cmn.NewErrXactNotFoundError("[" + uuid + "]")
+		t.writeErr(w, r, err, http.StatusNotFound, Silent)
+		return
+	}
+	xtc, ok := xctn.(interface{ FailedNames() []string })
+	if !ok {
+		t.writeErrf(w, r, "%s: %s does not support %q query", t, xctn.Name(), what)
+		return
+	}
+	t.writeJSON(w, r, xtc.FailedNames(), what)
+}
+
 func (t *target) xquery(w http.ResponseWriter, r *http.Request, what string, xactQuery xreg.Flt) {
 	stats, err := xreg.GetSnap(xactQuery)
 	if err == nil {
@@ -209,6 +233,8 @@ func (t *target) xstart(args *xact.ArgsMsg, bck *meta.Bck, msg *apc.ActMsg) (xid
 		wg.Add(1)
 		go t.runStoreCleanup(args.ID, wg, args.Buckets...)
 		wg.Wait()
+	case apc.ActMptCleanup:
+		go t.runMptCleanup(args.ID)
 	case apc.ActResilver:
 		if bck != nil {
 			nlog.Errorf(erfmb, args.Kind, bck)
@@ -285,5 +311,9 @@ func (t *target) httpxpost(w http.ResponseWriter, r *http.Request) {
 		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, "special", amsg.Value, err)
 		return
 	}
+	if err = tcomsg.InitRename(); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
 	xtco.Do(&tcomsg)
 }
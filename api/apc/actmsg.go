@@ -37,6 +37,7 @@ const (
 	ActBlobDl = "blob-download"
 
 	ActMakeNCopies = "make-n-copies"
+	NameRepairNC   = "repair" // ActMakeNCopies: ActMsg.Name to (also) restore missing copies (see api.RepairNCopies)
 	ActPutCopies   = "put-copies"
 
 	ActRebalance = "rebalance"
@@ -48,6 +49,7 @@ const (
 
 	ActLRU          = "lru"
 	ActStoreCleanup = "cleanup-store"
+	ActMptCleanup   = "mpt-cleanup" // abort stale S3 multipart uploads
 
 	ActEvictRemoteBck = "evict-remote-bck" // evict remote bucket's data
 	ActInvalListCache = "inval-listobj-cache"
@@ -136,6 +138,12 @@ const (
 	NodeDecommission = "decommission"
 )
 
+// node capabilities (local config "capabilities"; see also meta.Snode.Caps)
+const (
+	NodeCapETL = "etl" // can run ETL containers
+	NodeCapGPU = "gpu" // has GPU(s)
+)
+
 // ActMsg is a JSON-formatted control structures used in a majority of API calls
 type (
 	ActMsg struct {
@@ -39,7 +39,6 @@ type (
 		ListRange
 		TxnUUID string `json:"-"`
 		TCBMsg
-		ContinueOnError bool `json:"coer"`
 	}
 )
 
@@ -11,9 +11,10 @@ const (
 
 	QparamProps = "props" // e.g. "checksum, size"|"atime, size"|"cached"|"bucket, size"| ...
 
-	QparamUUID    = "uuid"     // xaction
-	QparamJobID   = "jobid"    // job
-	QparamETLName = "etl_name" // etl
+	QparamUUID     = "uuid"      // xaction
+	QparamJobID    = "jobid"     // job
+	QparamETLName  = "etl_name"  // etl
+	QparamETLRange = "etl_range" // etl: transform byte range "offset,length" of the object (see etl.ObjRange)
 
 	QparamRegex      = "regex"       // dsort: list regex
 	QparamOnlyActive = "only_active" // dsort: list only active
@@ -70,6 +71,10 @@ const (
 	QparamLogOff  = "offset"
 	QparamAllLogs = "all"
 
+	// Stream ETL pod logs (see api.ETLLogsStream)
+	QparamLogTail   = "tail"   // number of trailing lines
+	QparamLogFollow = "follow" // keep streaming new lines until canceled
+
 	// Archive filename and format (mime type)
 	QparamArchpath = "archpath"
 	QparamArchmime = "archmime"
@@ -207,11 +212,22 @@ const (
 	WhatXactStats       = "getxstats"   // stats: xaction by uuid
 	WhatQueryXactStats  = "qryxstats"   // stats: all matching xactions
 	WhatAllRunningXacts = "running_all" // e.g. e.g.: put-copies[D-ViE6HEL_j] list[H96Y7bhR2s] ...
+	WhatXactErrNames    = "xerrnames"   // x-tcb and x-tco by uuid: names of the source objects that failed to copy
 	// internal
 	WhatSnode    = "snode"
 	WhatICBundle = "ic_bundle"
 )
 
+// WhatNodeStatsAndStatus: optional (comma-separated) sections to return via QparamProps;
+// none specified - all sections; node's status, flags, and version are always included
+const (
+	NodeStatusMetrics    = "metrics"    // all metrics (counters, latencies, throughput, etc.)
+	NodeStatusThroughput = "throughput" // only throughput metrics (subset of the above)
+	NodeStatusCapacity   = "capacity"   // (target) used/available capacity and mountpaths
+	NodeStatusCluster    = "cluster"    // cluster info (see cifl.Info)
+	NodeStatusRebalance  = "rebalance"  // (target) rebalance snapshot
+)
+
 // QparamLogSev enum.
 const (
 	LogInfo = "info"
@@ -6,20 +6,51 @@ package apc
 
 import (
 	"errors"
+	"fmt"
+	"path"
+	"regexp"
 	"strings"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
 )
 
+// CopyBckMsg.NumWorkers: zero (default) means a single worker per mountpath
+// (or a few, when transforming); the maximum is capped by target's parallelism
+const MaxCopyWorkers = 128
+
 // copy & (offline) transform bucket to bucket
 type (
 	CopyBckMsg struct {
-		Prepend   string `json:"prepend"`     // destination naming, as in: dest-obj-name = Prepend + source-obj-name
-		Prefix    string `json:"prefix"`      // prefix to select matching _source_ objects or virtual directories
-		DryRun    bool   `json:"dry_run"`     // visit all source objects, don't make any modifications
-		Force     bool   `json:"force"`       // force running in presence of "limited coexistence" type conflicts
-		LatestVer bool   `json:"latest-ver"`  // see also: QparamLatestVer, 'versioning.validate_warm_get', PrefetchMsg
-		Sync      bool   `json:"synchronize"` // see also: 'versioning.synchronize'
+		Prepend      string `json:"prepend"`       // destination naming, as in: dest-obj-name = Prepend + source-obj-name
+		StripPrefix  string `json:"strip-prefix"`  // destination naming: strip this prefix from source-obj-name (prior to Prepend)
+		Prefix       string `json:"prefix"`        // prefix to select matching _source_ objects or virtual directories
+		DryRun       bool   `json:"dry_run"`       // visit all source objects, don't make any modifications
+		Force        bool   `json:"force"`         // force running in presence of "limited coexistence" type conflicts
+		LatestVer    bool   `json:"latest-ver"`    // see also: QparamLatestVer, 'versioning.validate_warm_get', PrefetchMsg
+		Sync         bool   `json:"synchronize"`   // see also: 'versioning.synchronize'
+		SkipExisting bool   `json:"skip-existing"` // leave existing destination objects untouched (is incompatible with Overwrite, LatestVer, Sync)
+		Overwrite    bool   `json:"overwrite"`     // overwrite existing destination objects even when identical (same checksum)
+		CloneProps   bool   `json:"clone-props"`   // new destination: inherit source props even when the source is remote (Cloud)
+		NumWorkers   int    `json:"num-workers"`   // x-tcb: number of concurrent workers per mountpath (0: default, see MaxCopyWorkers)
+
+		DeleteSrc       bool  `json:"delete-src"` // move: delete each source object upon its successful copy (is incompatible with SkipExisting, Sync)
+		ContinueOnError bool  `json:"coer"`       // on err, keep copying (and report failed objects upon completion)
+		BwLimit         int64 `json:"bw-limit"`   // max copying bandwidth, in bytes per second per target (0: unlimited)
+
+		// flow control: max bytes sent by a given target to other targets but not yet completed (0: unlimited);
+		// x-tco: applies to the entire (multi-object) job and is, therefore, defined by its first message
+		WindowSize int64 `json:"window-size,omitempty"`
+
+		// source object selection via glob patterns (path.Match syntax), e.g.: Include ["*.parquet"], Exclude ["tmp/*"];
+		// an object is copied if it matches any of the Include patterns (or Include is empty) and none of the Exclude
+		Include []string `json:"include,omitempty"`
+		Exclude []string `json:"exclude,omitempty"`
+
+		// destination naming via regex, as in: dest-obj-name = regexp(RenameMatch).ReplaceAllString(source-obj-name, RenameReplace)
+		RenameMatch   string         `json:"rename-match"`   // e.g. "raw/(.*)"
+		RenameReplace string         `json:"rename-replace"` // e.g. "cooked/$1"
+		rx            *regexp.Regexp // compiled RenameMatch (see InitRename - must be called prior to ToName)
 	}
 	Transform struct {
 		Name    string       `json:"id,omitempty"`
@@ -38,6 +69,72 @@ type (
 	}
 )
 
+////////////////
+// CopyBckMsg //
+////////////////
+
+func (msg *CopyBckMsg) ValidateLimits() error {
+	if msg.NumWorkers < 0 || msg.NumWorkers > MaxCopyWorkers {
+		return fmt.Errorf("invalid num-workers %d: expecting (0..%d) range", msg.NumWorkers, MaxCopyWorkers)
+	}
+	if msg.BwLimit < 0 {
+		return fmt.Errorf("invalid bw-limit %d (expecting non-negative number of bytes per second)", msg.BwLimit)
+	}
+	if msg.WindowSize < 0 {
+		return fmt.Errorf("invalid window-size %d (expecting non-negative number of bytes)", msg.WindowSize)
+	}
+	return nil
+}
+
+// validate and compile (regex-based) renaming, if requested
+func (msg *CopyBckMsg) InitRename() (err error) {
+	if msg.StripPrefix != "" && msg.Sync {
+		return fmt.Errorf("strip-prefix (%q) is incompatible with the request to synchronize buckets", msg.StripPrefix)
+	}
+	if msg.RenameMatch == "" {
+		if msg.RenameReplace != "" {
+			return errors.New("rename-replace requires rename-match (regex)")
+		}
+		return nil
+	}
+	if msg.Sync {
+		return fmt.Errorf("rename-match (%q) is incompatible with the request to synchronize buckets", msg.RenameMatch)
+	}
+	if msg.rx, err = regexp.Compile(msg.RenameMatch); err != nil {
+		return fmt.Errorf("invalid rename-match regex %q: %v", msg.RenameMatch, err)
+	}
+	return nil
+}
+
+func (msg *CopyBckMsg) ValidateGlobs() error {
+	for _, patterns := range [][]string{msg.Include, msg.Exclude} {
+		for _, pattern := range patterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("invalid glob pattern %q: %v", pattern, err)
+			}
+		}
+	}
+	return nil
+}
+
+// whether the named source object passes include/exclude filters (see ValidateGlobs)
+func (msg *CopyBckMsg) Selected(name string) bool {
+	for _, pattern := range msg.Exclude {
+		if ok, _ := path.Match(pattern, name); ok {
+			return false
+		}
+	}
+	if len(msg.Include) == 0 {
+		return true
+	}
+	for _, pattern := range msg.Include {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
 ////////////
 // TCBMsg //
 ////////////
@@ -49,8 +146,13 @@ func (msg *TCBMsg) Validate(isEtl bool) (err error) {
 	return
 }
 
-// Replace extension and add suffix if provided.
+// Strip prefix, replace extension, rename (regex), and prepend prefix - if provided.
 func (msg *TCBMsg) ToName(name string) string {
+	if msg.StripPrefix != "" {
+		if stripped := strings.TrimPrefix(name, msg.StripPrefix); stripped != "" {
+			name = stripped
+		}
+	}
 	if msg.Ext != nil {
 		if idx := strings.LastIndexByte(name, '.'); idx >= 0 {
 			ext := name[idx+1:]
@@ -59,6 +161,10 @@ func (msg *TCBMsg) ToName(name string) string {
 			}
 		}
 	}
+	debug.Assertf(msg.rx != nil || msg.RenameMatch == "", "rename-match %q: missing InitRename", msg.RenameMatch)
+	if msg.rx != nil {
+		name = msg.rx.ReplaceAllString(name, msg.RenameReplace)
+	}
 	if msg.Prepend != "" {
 		name = msg.Prepend + name
 	}
@@ -249,12 +249,22 @@ func EvictRemoteBucket(bp BaseParams, bck cmn.Bck, keepMD bool) error {
 // certain redundancy level (num copies).
 // Returns xaction ID if successful, an error otherwise.
 func MakeNCopies(bp BaseParams, bck cmn.Bck, copies int) (xid string, err error) {
+	return makeNCopies(bp, bck, copies, "")
+}
+
+// Same as above, with each object also checked for missing (e.g., lost with a failed disk) copies
+// that, if found, get restored.
+func RepairNCopies(bp BaseParams, bck cmn.Bck, copies int) (xid string, err error) {
+	return makeNCopies(bp, bck, copies, apc.NameRepairNC)
+}
+
+func makeNCopies(bp BaseParams, bck cmn.Bck, copies int, name string) (xid string, err error) {
 	bp.Method = http.MethodPost
 	reqParams := AllocRp()
 	{
 		reqParams.BaseParams = bp
 		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
-		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActMakeNCopies, Value: copies})
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActMakeNCopies, Value: copies, Name: name})
 		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
 		reqParams.Query = bck.NewQuery()
 	}
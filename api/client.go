@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/url"
 	"sync"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -29,12 +30,20 @@ const (
 type (
 	BaseParams struct {
 		Client *http.Client
+		Retry  *RetryPolicy // optional; nil: retry on connection refused/reset (see httpMaxRetries)
 		URL    string
 		Method string
 		Token  string
 		UA     string
 	}
 
+	// retry/backoff policy applied to all API calls made with the given BaseParams
+	RetryPolicy struct {
+		IsRetriable func(err error) bool // nil: connection refused, reset, and similar (see cos.IsRetriableConnErr)
+		MaxAttempts int                  // including the first attempt (values <= 1 mean no retries)
+		Delay       time.Duration        // initial delay between attempts (increases with each retry); 0: default
+	}
+
 	// ReqParams is used in constructing client-side API requests to aistore.
 	// Stores Query and Headers for providing arguments that are not used commonly in API requests
 	//  See also: cmn.HreqArgs
@@ -61,6 +70,7 @@ type (
 		client *http.Client
 		req    *http.Request
 		resp   *http.Response
+		tries  int
 	}
 	wrappedResp struct {
 		*http.Response
@@ -200,14 +210,18 @@ func (reqParams *ReqParams) do() (resp *http.Response, err error) {
 	SetAuxHeaders(req, &reqParams.BaseParams)
 
 	rr := reqResp{client: reqParams.BaseParams.Client, req: req}
-	err = cmn.NetworkCallWithRetry(&cmn.RetryArgs{
+	args := &cmn.RetryArgs{
 		Call:      rr.call,
 		Verbosity: cmn.RetryLogOff,
 		SoftErr:   httpMaxRetries,
 		Sleep:     httpRetrySleep,
 		BackOff:   true,
 		IsClient:  true,
-	})
+	}
+	if rp := reqParams.BaseParams.Retry; rp != nil {
+		rp.apply(args)
+	}
+	err = cmn.NetworkCallWithRetry(args)
 	resp = rr.resp
 	if err == nil {
 		return resp, nil
@@ -226,6 +240,25 @@ func (reqParams *ReqParams) do() (resp *http.Response, err error) {
 	return nil, err
 }
 
+// NOTE: counting all failed attempts - retriable or not - against MaxAttempts
+func (rp *RetryPolicy) apply(args *cmn.RetryArgs) {
+	var (
+		failed      int
+		isRetriable = rp.IsRetriable
+	)
+	if isRetriable == nil {
+		isRetriable = cos.IsRetriableConnErr
+	}
+	if rp.Delay > 0 {
+		args.Sleep = rp.Delay
+	}
+	args.SoftErr, args.HardErr = uint(max(rp.MaxAttempts, 1)), uint(max(rp.MaxAttempts, 1))
+	args.IsFatal = func(err error) bool {
+		failed++
+		return failed >= rp.MaxAttempts || !isRetriable(err)
+	}
+}
+
 // Check, Drain, Close
 func (reqParams *ReqParams) cdc(resp *http.Response) (err error) {
 	err = reqParams.checkResp(resp)
@@ -385,6 +418,12 @@ func (reqParams *ReqParams) checkResp(resp *http.Response) error {
 /////////////
 
 func (rr *reqResp) call() (status int, err error) {
+	if rr.tries > 0 && rr.req.GetBody != nil { // retrying: rewind request body
+		if rr.req.Body, err = rr.req.GetBody(); err != nil {
+			return 0, err
+		}
+	}
+	rr.tries++
 	rr.resp, err = rr.client.Do(rr.req) //nolint:bodyclose // closed by a caller
 	if rr.resp != nil {
 		status = rr.resp.StatusCode
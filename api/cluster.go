@@ -78,6 +78,19 @@ func GetClusterMap(bp BaseParams) (smap *meta.Smap, err error) {
 	return smap, err
 }
 
+// returns (active) targets that have all the specified capabilities (apc.NodeCapETL, et al.)
+func GetTargetsWithCaps(bp BaseParams, caps ...string) (meta.Nodes, error) {
+	flags, err := meta.ParseCaps(caps)
+	if err != nil {
+		return nil, err
+	}
+	smap, err := GetClusterMap(bp)
+	if err != nil {
+		return nil, err
+	}
+	return smap.TargetsWithCaps(flags), nil
+}
+
 // GetNodeClusterMap retrieves cluster map from the specified node.
 func GetNodeClusterMap(bp BaseParams, sid string) (smap *meta.Smap, err error) {
 	bp.Method = http.MethodGet
@@ -88,6 +88,34 @@ func ETLLogs(bp BaseParams, etlName string, targetID ...string) (logs etl.LogsBy
 	return
 }
 
+type ETLLogsArgs struct {
+	Writer io.Writer
+	Tail   int64 // number of trailing lines; zero: all
+	Follow bool  // keep streaming until the request (or the pod) is done
+}
+
+// ETLLogsStream writes a given target's ETL pod logs to args.Writer (as plain text)
+func ETLLogsStream(bp BaseParams, etlName, targetID string, args ETLLogsArgs) (int64, error) {
+	q := make(url.Values, 2)
+	q.Set(apc.QparamLogTail, strconv.FormatInt(args.Tail, 10))
+	if args.Follow {
+		q.Set(apc.QparamLogFollow, "true")
+	}
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathETL.Join(etlName, apc.ETLLogs, targetID)
+		reqParams.Query = q
+	}
+	wresp, err := reqParams.doWriter(args.Writer)
+	FreeRp(reqParams)
+	if err != nil {
+		return 0, err
+	}
+	return wresp.n, nil
+}
+
 func ETLMetrics(params BaseParams, etlName string) (healths etl.CPUMemByTarget, err error) {
 	params.Method = http.MethodGet
 	path := apc.URLPathETL.Join(etlName, apc.ETLMetrics)
@@ -5,14 +5,24 @@
 package api
 
 import (
+	"encoding/xml"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 
+	"github.com/NVIDIA/aistore/ais/s3"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 )
 
+// optional args for ListMultipartUploads
+type ListMptUploadsArgs struct {
+	IDMarker   string // start listing after this upload ID
+	MaxUploads int    // page size (zero: server default, single page)
+	Limit      int    // stop after this many uploads (zero: no limit)
+}
+
 // s3/<bucket-name>/<object-name>
 func GetObjectS3(bp BaseParams, bck cmn.Bck, objectName string, args ...GetArgs) (int64, error) {
 	var (
@@ -39,3 +49,64 @@ func GetObjectS3(bp BaseParams, bck cmn.Bck, objectName string, args ...GetArgs)
 	}
 	return wresp.n, nil
 }
+
+// s3/<bucket-name>?uploads
+// lists in-progress multipart uploads, page by page (following NextUploadIdMarker)
+func ListMultipartUploads(bp BaseParams, bck cmn.Bck, args *ListMptUploadsArgs) (*s3.ListMptUploadsResult, error) {
+	if args == nil {
+		args = &ListMptUploadsArgs{}
+	}
+	var (
+		all    = &s3.ListMptUploadsResult{Bucket: bck.Name, UploadIDMarker: args.IDMarker}
+		marker = args.IDMarker
+	)
+	for {
+		page, err := listMptUploadsPage(bp, bck, marker, args.MaxUploads)
+		if err != nil {
+			return nil, err
+		}
+		all.Uploads = append(all.Uploads, page.Uploads...)
+		if args.Limit > 0 && len(all.Uploads) >= args.Limit {
+			all.Uploads = all.Uploads[:args.Limit]
+			all.IsTruncated = page.IsTruncated || len(page.Uploads) > args.Limit
+			break
+		}
+		if !page.IsTruncated || page.NextUploadIDMarker == "" || page.NextUploadIDMarker == marker {
+			break
+		}
+		marker = page.NextUploadIDMarker
+	}
+	if all.IsTruncated && len(all.Uploads) > 0 {
+		all.NextUploadIDMarker = all.Uploads[len(all.Uploads)-1].UploadID
+	}
+	return all, nil
+}
+
+func listMptUploadsPage(bp BaseParams, bck cmn.Bck, marker string, maxUploads int) (*s3.ListMptUploadsResult, error) {
+	q := url.Values{s3.QparamMptUploads: []string{""}}
+	if marker != "" {
+		q.Set(s3.QparamMptUploadIDMarker, marker)
+	}
+	if maxUploads > 0 {
+		q.Set(s3.QparamMptMaxUploads, strconv.Itoa(maxUploads))
+	}
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathS3.Join(bck.Name)
+		reqParams.Query = q
+	}
+	body, _, err := reqParams.doReader()
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	page := &s3.ListMptUploadsResult{}
+	err = xml.NewDecoder(body).Decode(page)
+	body.Close()
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
@@ -7,6 +7,7 @@ package api
 import (
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/core/meta"
@@ -60,6 +61,21 @@ func GetClusterStats(bp BaseParams) (res stats.Cluster, err error) {
 	return
 }
 
+// returns disk stats of all targets (keyed by target ID) assembled by the proxy
+// in a single call; compare with GetDiskStats (below)
+func GetClusterDiskStats(bp BaseParams) (res map[string]ios.AllDiskStats, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatDiskStats}}
+	}
+	_, err = reqParams.DoReqAny(&res)
+	FreeRp(reqParams)
+	return res, err
+}
+
 //
 // node ----------------------
 //
@@ -84,10 +100,27 @@ func GetDaemonStats(bp BaseParams, node *meta.Snode) (ds *stats.Node, err error)
 	return ds, err
 }
 
-// returns both node's stats (as above) and extended status
-func GetStatsAndStatus(bp BaseParams, node *meta.Snode) (ds *stats.NodeStatus, err error) {
+// returns both node's stats (as above) and extended status;
+// optionally, only the selected sections (apc.NodeStatusMetrics, et al.) - to reduce the payload
+func GetStatsAndStatus(bp BaseParams, node *meta.Snode, sections ...string) (ds *stats.NodeStatus, err error) {
 	ds = &stats.NodeStatus{}
-	err = anyStats(bp, node.ID(), apc.WhatNodeStatsAndStatus, ds)
+	if len(sections) == 0 {
+		err = anyStats(bp, node.ID(), apc.WhatNodeStatsAndStatus, ds)
+		return ds, err
+	}
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.S
+		reqParams.Query = url.Values{
+			apc.QparamWhat:  []string{apc.WhatNodeStatsAndStatus},
+			apc.QparamProps: []string{strings.Join(sections, apc.LsPropsSepa)},
+		}
+		reqParams.Header = http.Header{apc.HdrNodeID: []string{node.ID()}}
+	}
+	_, err = reqParams.DoReqAny(ds)
+	FreeRp(reqParams)
 	return ds, err
 }
 
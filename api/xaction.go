@@ -104,6 +104,25 @@ func QueryXactionSnaps(bp BaseParams, args *xact.ArgsMsg) (xs xact.MultiSnap, er
 	return
 }
 
+// GetXactErrNames returns the names of the source objects that a given x-tcb or x-tco
+// failed to copy (up to 1000 per target, see also ContinueOnError) - by target ID
+// NOTE: xaction snapshots (see QueryXactionSnaps) carry only a few
+func GetXactErrNames(bp BaseParams, xid string) (names map[string][]string, err error) {
+	msg := xact.QueryMsg{ID: xid}
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatXactErrNames}}
+	}
+	_, err = reqParams.DoReqAny(&names)
+	FreeRp(reqParams)
+	return
+}
+
 // GetOneXactionStatus queries one of the IC (proxy) members for status
 // of the `args`-identified xaction.
 // NOTE:
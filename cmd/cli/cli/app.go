@@ -14,6 +14,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/cmd/cli/config"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
@@ -27,6 +28,8 @@ const (
 	cliName  = "ais"
 	ua       = "ais/cli"
 	metadata = "md"
+
+	defaultRetries = 5 // when only '--retry-delay' is specified
 )
 
 const (
@@ -271,7 +274,8 @@ func (a *acli) init(version string, emptyCmdline bool) {
 	app.Version = version
 	app.EnableBashCompletion = true
 	app.HideHelp = true
-	app.Flags = []cli.Flag{cli.HelpFlag}
+	app.Flags = []cli.Flag{cli.HelpFlag, retriesFlag, retryDelayFlag}
+	app.Before = setRetryPolicy
 	app.CommandNotFound = commandNotFoundHandler
 	app.OnUsageError = onUsageErrorHandler
 	app.Metadata = map[string]any{metadata: a.longRun}
@@ -285,6 +289,24 @@ func (a *acli) init(version string, emptyCmdline bool) {
 	a.setupCommands(emptyCmdline)
 }
 
+// global '--retries' and '--retry-delay'
+func setRetryPolicy(c *cli.Context) error {
+	retries, delay := fl1n(retriesFlag.Name), fl1n(retryDelayFlag.Name)
+	if !c.GlobalIsSet(retries) && !c.GlobalIsSet(delay) {
+		return nil
+	}
+	rp := &api.RetryPolicy{MaxAttempts: c.GlobalInt(retries), Delay: c.GlobalDuration(delay)}
+	if rp.MaxAttempts < 0 || rp.Delay < 0 {
+		return fmt.Errorf("invalid %s=%d and/or %s=%v", flprn(retriesFlag), rp.MaxAttempts, flprn(retryDelayFlag), rp.Delay)
+	}
+	if !c.GlobalIsSet(retries) {
+		rp.MaxAttempts = defaultRetries
+	}
+	apiBP.Retry = rp
+	authParams.Retry = rp
+	return nil
+}
+
 func (a *acli) setupCommands(emptyCmdline bool) {
 	app := a.app
 
@@ -448,6 +448,21 @@ func configureNCopies(c *cli.Context, bck cmn.Bck, copies int) (err error) {
 	return nil
 }
 
+// re-run make-n-copies with the (unchanged) configured number of copies to restore missing replicas
+func repairNCopies(c *cli.Context, bck cmn.Bck, copies int) error {
+	xid, err := api.RepairNCopies(apiBP, bck, copies)
+	if err != nil {
+		return err
+	}
+	if flagIsSet(c, nonverboseFlag) {
+		fmt.Fprintln(c.App.Writer, xid)
+		return nil
+	}
+	baseMsg := fmt.Sprintf("Repairing %d-way mirror %s. ", copies, bck.Cname(""))
+	actionDone(c, baseMsg+toMonitorMsg(c, xid, ""))
+	return nil
+}
+
 // erasure code the entire bucket
 func ecEncode(c *cli.Context, bck cmn.Bck, data, parity int) (err error) {
 	var xid string
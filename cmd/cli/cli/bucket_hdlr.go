@@ -82,18 +82,35 @@ var (
 		commandCopy: {
 			listFlag,
 			templateFlag,
+			copyFromFileFlag,
 			verbObjPrefixFlag,
+			copyIncludeFlag,
+			copyExcludeFlag,
 			copyAllObjsFlag,
 			continueOnErrorFlag,
+			copyRetriesFlag,
 			forceFlag,
+			copyOverwriteFlag,
+			copySkipExistingFlag,
+			copyDeleteSrcFlag,
 			copyDryRunFlag,
 			copyPrependFlag,
+			copyStripPrefixFlag,
+			copyRenameMatchFlag,
+			copyRenameReplaceFlag,
+			copyPropsFlag,
+			copyNumWorkersFlag,
+			copyBwLimitFlag,
+			copyWindowFlag,
+			yesFlag,
+			copyETLFlag,
 			progressFlag,
 			refreshFlag,
 			waitFlag,
 			waitJobXactFinishedFlag,
 			latestVerFlag,
 			syncFlag,
+			jsonFlag,
 			nonverboseFlag,
 		},
 		commandRename: {
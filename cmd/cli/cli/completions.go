@@ -49,6 +49,8 @@ var (
 		// rest
 		"write_policy.data":                   apc.SupportedWritePolicy,
 		"write_policy.md":                     apc.SupportedWritePolicy,
+		"mirror.placement":                    cmn.SupportedPlacements,
+		"mirror.affinity":                     cmn.SupportedAffinities,
 		"ec.compression":                      apc.SupportedCompression,
 		"compression.checksum":                apc.SupportedCompression,
 		"rebalance.compression":               apc.SupportedCompression,
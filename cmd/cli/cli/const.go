@@ -410,6 +410,19 @@ var (
 			indent4 + "\ta/b that have names (relative to this directory) starting with the letter c",
 	}
 
+	//
+	// global (app) flags: retry/backoff policy for all API calls (see api.RetryPolicy)
+	//
+	retriesFlag = cli.IntFlag{
+		Name:  "retries",
+		Usage: "maximum number of attempts per API call when failing with a retriable (e.g., connection refused) error",
+	}
+	retryDelayFlag = DurationFlag{
+		Name: "retry-delay",
+		Usage: "initial delay between API call attempts (increases with each retry);\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+	}
+
 	//
 	// longRunFlags
 	//
@@ -503,7 +516,11 @@ var (
 		Name:  "page-size",
 		Usage: "maximum number of names per page (0 - the maximum is defined by the corresponding backend)",
 	}
-	copiesFlag   = cli.IntFlag{Name: "copies", Usage: "number of object replicas", Value: 1, Required: true}
+	copiesFlag = cli.IntFlag{Name: "copies", Usage: "number of object replicas", Value: 1}
+	repairFlag = cli.BoolFlag{
+		Name:  "repair",
+		Usage: "restore the bucket's configured number of copies (e.g., replicas lost due to disk failure)",
+	}
 	maxPagesFlag = cli.IntFlag{Name: "max-pages", Usage: "display up to this number pages of bucket objects"}
 
 	validateSummaryFlag = cli.BoolFlag{
@@ -636,6 +653,11 @@ var (
 		Usage: "ais target waiting time for POD to become ready;\n" +
 			indent4 + "\tvalid time units: " + timeUnits,
 	}
+	etlObjTimeoutFlag = DurationFlag{
+		Name: "obj-timeout",
+		Usage: "server-side timeout transforming a single object on the fly (inline, via GET) - default: 45s;\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+	}
 	waitJobXactFinishedFlag = DurationFlag{
 		Name: "timeout",
 		Usage: "maximum time to wait for a job to finish; if omitted: wait forever or until Ctrl-C;\n" +
@@ -870,14 +892,91 @@ var (
 	// Copy Bucket
 	copyDryRunFlag = cli.BoolFlag{
 		Name:  "dry-run",
-		Usage: "show total size of new objects without really creating them",
+		Usage: "show source => destination names (first 10) and total size of new objects without really creating them",
+	}
+	copyOverwriteFlag = cli.BoolFlag{
+		Name:  "overwrite",
+		Usage: "overwrite existing destination objects unconditionally (even when they are identical)",
+	}
+	copySkipExistingFlag = cli.BoolFlag{
+		Name: "skip-existing",
+		Usage: "leave existing destination objects untouched (do not overwrite);\n" +
+			indent4 + "\tincompatible with '--overwrite', '--latest', and '--sync'",
+	}
+	copyIncludeFlag = cli.StringFlag{
+		Name: "include",
+		Usage: "copy only source objects that match any of the specified (comma-separated) glob patterns, e.g.:\n" +
+			indent4 + "\t--include '*.parquet'           - copy only parquet files\n" +
+			indent4 + "\t--include '*.jpg,*.png'         - copy JPEG and PNG images",
+	}
+	copyExcludeFlag = cli.StringFlag{
+		Name: "exclude",
+		Usage: "do not copy source objects that match any of the specified (comma-separated) glob patterns, e.g.:\n" +
+			indent4 + "\t--include '*.parquet' --exclude 'tmp/*'  - copy parquet files except those in the virtual directory 'tmp'",
+	}
+	copyDeleteSrcFlag = cli.BoolFlag{
+		Name: "delete-source",
+		Usage: "move objects: delete each source object upon its successful copy (a failed copy never deletes its source);\n" +
+			indent4 + "\tincompatible with '--skip-existing' and '--sync'",
 	}
 	copyPrependFlag = cli.StringFlag{
-		Name: "prepend",
+		Name: "prepend,add-prefix",
 		Usage: "prefix to prepend to every copied object name, e.g.:\n" +
 			indent4 + "\t--prepend=abc\t- prefix all copied object names with \"abc\"\n" +
 			indent4 + "\t--prepend=abc/\t- copy objects into a virtual directory \"abc\" (note trailing filepath separator)",
 	}
+	copyStripPrefixFlag = cli.StringFlag{
+		Name: "strip-prefix",
+		Usage: "prefix to strip from every copied object name (can be combined with '--prepend'), e.g.:\n" +
+			indent4 + "\t--strip-prefix=archive/\t\t\t- copy 'archive/2023/a.tar' as '2023/a.tar'\n" +
+			indent4 + "\t--strip-prefix=archive/ --prepend=backup/\t- copy 'archive/2023/a.tar' as 'backup/2023/a.tar'",
+	}
+
+	copyFromFileFlag = cli.StringFlag{
+		Name: "from-file",
+		Usage: "path to a text file containing names of the source objects to copy, one name per line\n" +
+			indent4 + "\t(empty lines and lines starting with '#' are ignored; see also: '--list')",
+	}
+	copyRenameMatchFlag = cli.StringFlag{
+		Name: "rename-match",
+		Usage: "regular expression to match (and rename) source object names, e.g.:\n" +
+			indent4 + "\t--rename-match 'raw/(.*)' --rename-replace 'cooked/$1'\t- copy raw/a/b.jpg as cooked/a/b.jpg\n" +
+			indent4 + "\t(non-matching names remain unchanged; see also: '--prepend')",
+	}
+	copyRenameReplaceFlag = cli.StringFlag{
+		Name:  "rename-replace",
+		Usage: "replacement string for '--rename-match' (may contain $1, $2, etc. references to the matched groups)",
+	}
+	copyNumWorkersFlag = cli.IntFlag{
+		Name: numWorkersFlag.Name,
+		Usage: "number of concurrent workers per target mountpath when copying entire bucket;\n" +
+			indent4 + "\tzero or omitted - system default (one worker per mountpath); maximum 128 (capped by the number of target CPUs)",
+	}
+	copyRetriesFlag = cli.IntFlag{
+		Name: "retries",
+		Usage: "when waiting for copying to finish ('--wait'), resubmit objects that failed to copy up to so many times;\n" +
+			indent4 + "\tuse together with '--cont-on-err' to copy everything else in the meantime",
+	}
+	copyBwLimitFlag = cli.StringFlag{
+		Name: "bw-limit",
+		Usage: "maximum copying bandwidth per target, in bytes per second (IEC or SI units, or \"raw\" bytes), e.g.:\n" +
+			indent4 + "\t--bw-limit 100MiB\t- copy at most 100MiB/s per target; zero or omitted - unlimited",
+	}
+	copyWindowFlag = cli.StringFlag{
+		Name: "window-size",
+		Usage: "flow control: maximum size of objects that each target has sent to other targets but not yet completed\n" +
+			indent4 + "\t(IEC or SI units, or \"raw\" bytes), e.g.: '--window-size 1GiB'; zero or omitted - unlimited",
+	}
+	copyPropsFlag = cli.BoolFlag{
+		Name: "props",
+		Usage: "when creating new destination bucket, copy source bucket's properties (checksum, mirror, EC, LRU, write policy, access)\n" +
+			indent4 + "\teven when the source is remote (Cloud) - note that properties of 'ais://' sources are always copied",
+	}
+	copyETLFlag = cli.StringFlag{
+		Name: "etl",
+		Usage: "name of the (initialized and running) ETL to transform objects while copying, e.g.:\n" +
+			indent4 + "\t'ais cp ais://src ais://dst --etl my-etl'\t- same as 'ais etl bucket my-etl ais://src ais://dst'",
+	}
 
 	// ETL
 	etlExtFlag  = cli.StringFlag{Name: "ext", Usage: "mapping from old to new extensions of transformed objects' names"}
@@ -886,6 +985,14 @@ var (
 		Usage:    "unique ETL name (leaving this field empty will have unique ID auto-generated)",
 		Required: true,
 	}
+	etlLogsTailFlag = cli.IntFlag{
+		Name:  "tail",
+		Usage: "show only the specified number of most recent log lines (requires TARGET_ID)",
+	}
+	etlLogsFollowFlag = cli.BoolFlag{
+		Name:  "follow,f",
+		Usage: "keep streaming ETL pod logs until interrupted (requires TARGET_ID)",
+	}
 	etlBucketRequestTimeout = DurationFlag{
 		Name: "etl-timeout",
 		Usage: "server-side timeout transforming a single object;\n" +
@@ -914,6 +1021,8 @@ var (
 			indent4 + "\t - 'hrev' or 'hrev://' - same, but aistore nodes will reverse-proxy requests to their respective ETL containers)\n" +
 			indent4 + "\t - 'io' or 'io://' - for each request an aistore node will: run ETL container locally, write data\n" +
 			indent4 + "\t   to its standard input and then read transformed data from the standard output\n" +
+			indent4 + "\t - 'ws' or 'ws://' - aistore nodes will keep a persistent WebSocket connection to their respective\n" +
+			indent4 + "\t   ETL containers and send objects to transform as binary frames (init spec only)\n" +
 			indent4 + "\t For more defails, see https://aiatscale.org/docs/etl#communication-mechanisms\n",
 	}
 
@@ -7,15 +7,18 @@ package cli
 import (
 	"fmt"
 	"net/http"
+	"os"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/urfave/cli"
 	"github.com/vbauerster/mpb/v4"
+	"golang.org/x/term"
 )
 
 type cprCtx struct {
@@ -33,9 +36,18 @@ type cprCtx struct {
 	}
 	timeout, sleep time.Duration
 	// runtime
+	started  time.Time
 	objs     int64
 	size     int64
 	sinceUpd time.Duration
+	textOnly bool // not a terminal: periodic text lines instead of progress bars
+}
+
+// progress bars require a terminal; otherwise (e.g., output redirected to a file)
+// fall back to reporting progress via periodic text lines
+func isTerminal(c *cli.Context) bool {
+	f, ok := c.App.Writer.(*os.File)
+	return ok && term.IsTerminal(int(f.Fd()))
 }
 
 func (cpr *cprCtx) copyBucket(c *cli.Context, bckFrom, bckTo cmn.Bck, msg *apc.CopyBckMsg, fltPresence int) error {
@@ -81,10 +93,13 @@ func (cpr *cprCtx) copyBucket(c *cli.Context, bckFrom, bckTo cmn.Bck, msg *apc.C
 		progress *mpb.Progress
 		bars     []*mpb.Bar
 		objsArg  = barArgs{barType: unitsArg, barText: "Copied objects:", total: cpr.totals.objs}
-		sizeArg  = barArgs{barType: sizeArg, barText: "Copied size:   ", total: cpr.totals.size}
+		sizeArg  = barArgs{barType: sizeArg, barText: "Copied size:   ", total: cpr.totals.size, speed: true}
 	)
-	progress, bars = simpleBar(objsArg, sizeArg)
-	cpr.barObjs, cpr.barSize = bars[0], bars[1]
+	cpr.textOnly = !isTerminal(c)
+	if !cpr.textOnly {
+		progress, bars = simpleBar(objsArg, sizeArg)
+		cpr.barObjs, cpr.barSize = bars[0], bars[1]
+	}
 
 	cpr.xid, err = api.CopyBucket(apiBP, bckFrom, bckTo, msg, fltPresence)
 	if err != nil {
@@ -105,7 +120,9 @@ func (cpr *cprCtx) copyBucket(c *cli.Context, bckFrom, bckTo cmn.Bck, msg *apc.C
 
 	// 3. poll x-copy-bucket asynchronously and update the progress
 	cpr.do(c)
-	progress.Wait()
+	if progress != nil {
+		progress.Wait()
+	}
 
 	// 4. done
 	err = <-cpr.errCh
@@ -122,11 +139,16 @@ func (cpr *cprCtx) multiobj(c *cli.Context, text string) (err error) {
 		bars     []*mpb.Bar
 		objsArg  = barArgs{barType: unitsArg, barText: text, total: cpr.totals.objs}
 	)
-	progress, bars = simpleBar(objsArg)
-	cpr.barObjs = bars[0]
+	cpr.textOnly = !isTerminal(c)
+	if !cpr.textOnly {
+		progress, bars = simpleBar(objsArg)
+		cpr.barObjs = bars[0]
+	}
 
 	cpr.do(c)
-	progress.Wait()
+	if progress != nil {
+		progress.Wait()
+	}
 
 	// 4. done
 	err = <-cpr.errCh
@@ -149,6 +171,7 @@ func (cpr *cprCtx) do(c *cli.Context) {
 		totalWait time.Duration
 		xargs     = xact.ArgsMsg{ID: cpr.xid}
 	)
+	cpr.started = time.Now()
 outer:
 	for {
 		var (
@@ -186,6 +209,9 @@ outer:
 		}
 		cpr.updObjs(objs)
 		cpr.updSize(size)
+		if cpr.textOnly {
+			fmt.Fprintln(c.App.Writer, cpr.loghdr+": "+cpr.log())
+		}
 		if cpr.objs >= cpr.totals.objs && cpr.size >= cpr.totals.size {
 			if nrun > 0 {
 				time.Sleep(cpr.sleep)
@@ -257,5 +283,9 @@ func (cpr *cprCtx) abortSize() {
 }
 
 func (cpr *cprCtx) log() string {
-	return fmt.Sprintf("objs %d/%d, size %d/%d", cpr.objs, cpr.totals.objs, cpr.size, cpr.totals.size)
+	s := fmt.Sprintf("objs %d/%d, size %d/%d", cpr.objs, cpr.totals.objs, cpr.size, cpr.totals.size)
+	if elapsed := time.Since(cpr.started); !cpr.started.IsZero() && elapsed > time.Second {
+		s += ", throughput " + cos.ToSizeIEC(int64(float64(cpr.size)/elapsed.Seconds()), 2) + "/s"
+	}
+	return s
 }
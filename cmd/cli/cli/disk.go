@@ -6,7 +6,6 @@
 package cli
 
 import (
-	"context"
 	"fmt"
 	"sort"
 
@@ -15,66 +14,45 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/core/meta"
-	"github.com/NVIDIA/aistore/ios"
-	"golang.org/x/sync/errgroup"
 )
 
-type (
-	dstats struct {
-		tid   string
-		stats ios.AllDiskStats
+func getDiskStats(smap *meta.Smap, tid string) ([]teb.DiskStatsHelper, error) {
+	if tid == "" {
+		return getClusterDiskStats(smap.CountActiveTs())
 	}
-	dstatsCtx struct {
-		tid string
-		ch  chan dstats
+	tsi := smap.GetNode(tid)
+	if tsi.InMaintOrDecomm() {
+		return nil, fmt.Errorf("target %s is unaivailable at this point", tsi.StringEx())
 	}
-)
-
-func (ctx *dstatsCtx) get() error {
-	diskStats, err := api.GetDiskStats(apiBP, ctx.tid)
+	diskStats, err := api.GetDiskStats(apiBP, tid)
 	if err != nil {
-		return V(err)
+		return nil, V(err)
 	}
-	ctx.ch <- dstats{stats: diskStats, tid: ctx.tid}
-	return nil
-}
-
-func getDiskStats(smap *meta.Smap, tid string) ([]teb.DiskStatsHelper, error) {
-	var (
-		targets = smap.Tmap
-		l       = smap.CountActiveTs()
-	)
-	if tid != "" {
-		tsi := smap.GetNode(tid)
-		if tsi.InMaintOrDecomm() {
-			return nil, fmt.Errorf("target %s is unaivailable at this point", tsi.StringEx())
-		}
-		targets = meta.NodeMap{tid: tsi}
-		l = 1
-	}
-	dsh := make([]teb.DiskStatsHelper, 0, l)
-	ch := make(chan dstats, l)
-
-	wg, _ := errgroup.WithContext(context.Background())
-	for tid, tsi := range targets {
-		if tsi.InMaintOrDecomm() {
-			continue
-		}
-		ctx := &dstatsCtx{ch: ch, tid: tid}
-		wg.Go(ctx.get) // api.GetDiskStats
+	dsh := make([]teb.DiskStatsHelper, 0, len(diskStats))
+	for name, stat := range diskStats {
+		dsh = append(dsh, teb.DiskStatsHelper{TargetID: tid, DiskName: name, Stat: stat})
 	}
+	sortDiskStats(dsh)
+	return dsh, nil
+}
 
-	err := wg.Wait()
-	close(ch)
+// all targets via a single (proxy-assembled) call
+func getClusterDiskStats(l int) ([]teb.DiskStatsHelper, error) {
+	all, err := api.GetClusterDiskStats(apiBP)
 	if err != nil {
-		return nil, err
+		return nil, V(err)
 	}
-	for res := range ch {
-		for name, stat := range res.stats {
-			dsh = append(dsh, teb.DiskStatsHelper{TargetID: res.tid, DiskName: name, Stat: stat})
+	dsh := make([]teb.DiskStatsHelper, 0, l)
+	for tid, stats := range all {
+		for name, stat := range stats {
+			dsh = append(dsh, teb.DiskStatsHelper{TargetID: tid, DiskName: name, Stat: stat})
 		}
 	}
+	sortDiskStats(dsh)
+	return dsh, nil
+}
 
+func sortDiskStats(dsh []teb.DiskStatsHelper) {
 	sort.Slice(dsh, func(i, j int) bool {
 		if dsh[i].TargetID != dsh[j].TargetID {
 			return dsh[i].TargetID < dsh[j].TargetID
@@ -84,8 +62,6 @@ func getDiskStats(smap *meta.Smap, tid string) ([]teb.DiskStatsHelper, error) {
 		}
 		return dsh[i].Stat.Util > dsh[j].Stat.Util
 	})
-
-	return dsh, nil
 }
 
 func collapseDisks(dsh []teb.DiskStatsHelper, numTs int) {
@@ -112,12 +88,18 @@ func collapseDisks(dsh []teb.DiskStatsHelper, numTs int) {
 		dst.Stat.WBps += src.Stat.WBps
 		dst.Stat.Wavg += src.Stat.Wavg
 		dst.Stat.Util += src.Stat.Util
+		dst.Stat.Rlat += src.Stat.Rlat
+		dst.Stat.Wlat += src.Stat.Wlat
+		dst.Stat.Aqu += src.Stat.Aqu
 	}
 	for tid, dst := range tsums {
 		dn := int64(dnums[tid])
 		dst.Stat.Ravg = cos.DivRound(dst.Stat.Ravg, dn)
 		dst.Stat.Wavg = cos.DivRound(dst.Stat.Wavg, dn)
 		dst.Stat.Util = cos.DivRound(dst.Stat.Util, dn)
+		dst.Stat.Rlat = cos.DivRound(dst.Stat.Rlat, dn)
+		dst.Stat.Wlat = cos.DivRound(dst.Stat.Wlat, dn)
+		dst.Stat.Aqu /= float64(dn)
 	}
 	// finally, reappend & re-sort
 	dsh = dsh[:0]
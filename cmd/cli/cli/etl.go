@@ -36,6 +36,7 @@ var (
 			argTypeFlag,
 			chunkSizeFlag,
 			waitPodReadyTimeoutFlag,
+			etlObjTimeoutFlag,
 			etlNameFlag,
 		},
 		cmdSpec: {
@@ -43,6 +44,7 @@ var (
 			commTypeFlag,
 			argTypeFlag,
 			waitPodReadyTimeoutFlag,
+			etlObjTimeoutFlag,
 			etlNameFlag,
 		},
 		cmdStop: {
@@ -62,6 +64,7 @@ var (
 			// TODO: progressFlag,
 			waitFlag,
 			waitJobXactFinishedFlag,
+			yesFlag,
 		},
 		cmdStart: {},
 	}
@@ -131,6 +134,7 @@ var (
 		Name:         cmdViewLogs,
 		Usage:        "view ETL logs",
 		ArgsUsage:    etlNameArgument + " " + optionalTargetIDArgument,
+		Flags:        []cli.Flag{etlLogsTailFlag, etlLogsFollowFlag},
 		Action:       etlLogsHandler,
 		BashComplete: etlIDCompletions,
 	}
@@ -206,6 +210,7 @@ func etlInitSpecHandler(c *cli.Context) (err error) {
 		msg.IDX = parseStrFlag(c, etlNameFlag)
 		msg.CommTypeX = parseStrFlag(c, commTypeFlag)
 		msg.ArgTypeX = parseStrFlag(c, argTypeFlag)
+		msg.ObjTimeout = cos.Duration(parseDurationFlag(c, etlObjTimeoutFlag))
 		msg.Spec = spec
 	}
 	if !strings.HasSuffix(msg.CommTypeX, etl.CommTypeSeparator) {
@@ -277,6 +282,7 @@ func etlInitCodeHandler(c *cli.Context) (err error) {
 	}
 
 	msg.Timeout = cos.Duration(parseDurationFlag(c, waitPodReadyTimeoutFlag))
+	msg.ObjTimeout = cos.Duration(parseDurationFlag(c, etlObjTimeoutFlag))
 
 	// funcs
 	msg.Funcs.Transform = parseStrFlag(c, funcTransformFlag)
@@ -375,6 +381,26 @@ func etlLogsHandler(c *cli.Context) (err error) {
 	if c.NArg() == 0 {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
 	}
+	if flagIsSet(c, etlLogsTailFlag) || flagIsSet(c, etlLogsFollowFlag) {
+		if targetID == "" {
+			return missingArgumentsError(c, "TARGET_ID (to stream ETL logs from)")
+		}
+		args := api.ETLLogsArgs{
+			Writer: c.App.Writer,
+			Tail:   int64(parseIntFlag(c, etlLogsTailFlag)),
+			Follow: flagIsSet(c, etlLogsFollowFlag),
+		}
+		bp := apiBP
+		if args.Follow {
+			client := *bp.Client // (following is not subject to the configured HTTP timeout)
+			client.Timeout = 0
+			bp.Client = &client
+		}
+		if _, err := api.ETLLogsStream(bp, id, targetID, args); err != nil {
+			return V(err)
+		}
+		return nil
+	}
 	logs, err := api.ETLLogs(apiBP, id, targetID)
 	if err != nil {
 		return V(err)
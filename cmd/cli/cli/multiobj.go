@@ -37,7 +37,10 @@ func runTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etlName
 	)
 	// 1. list or template
 	if listObjs != "" {
-		lrMsg.ObjNames = splitCsv(listObjs)
+		lrMsg.ObjNames = uniqueNames(splitCsv(listObjs))
+		if len(lrMsg.ObjNames) == 0 {
+			return incorrectUsageMsg(c, "%s: empty list of object names", qflprn(listFlag))
+		}
 		numObjs = int64(len(lrMsg.ObjNames))
 	} else if tmplObjs == "" {
 		// motivation: copy the entire bucket via x-tco rather than x-tcb
@@ -67,8 +70,40 @@ func runTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etlName
 		}
 		msg.LatestVer = flagIsSet(c, latestVerFlag)
 		msg.Sync = flagIsSet(c, syncFlag)
+		msg.Overwrite = flagIsSet(c, copyOverwriteFlag)
+		msg.SkipExisting = flagIsSet(c, copySkipExistingFlag)
+		msg.DeleteSrc = flagIsSet(c, copyDeleteSrcFlag)
+		msg.CloneProps = flagIsSet(c, copyPropsFlag)
+		msg.Prepend = parseStrFlag(c, copyPrependFlag)
+		msg.StripPrefix = parseStrFlag(c, copyStripPrefixFlag)
+		msg.RenameMatch = parseStrFlag(c, copyRenameMatchFlag)
+		msg.RenameReplace = parseStrFlag(c, copyRenameReplaceFlag)
+		msg.Include, msg.Exclude = _globs(c)
 		msg.ContinueOnError = flagIsSet(c, continueOnErrorFlag)
 	}
+	if err := _checkSkipExisting(&msg.CopyBckMsg, bckFrom); err != nil {
+		return err
+	}
+	bps, errV := _sizeFlag(c, copyBwLimitFlag)
+	if errV != nil {
+		return errV
+	}
+	msg.BwLimit = bps
+	if msg.WindowSize, errV = _sizeFlag(c, copyWindowFlag); errV != nil {
+		return errV
+	}
+	if err := msg.ValidateLimits(); err != nil {
+		return err
+	}
+	if err := msg.InitRename(); err != nil {
+		return err
+	}
+	if err := msg.ValidateGlobs(); err != nil {
+		return err
+	}
+	if flagIsSet(c, copyNumWorkersFlag) {
+		actionWarn(c, qflprn(copyNumWorkersFlag)+" applies only when copying entire bucket (ignoring)")
+	}
 	// 3. start copying/transforming
 	var (
 		xid   string
@@ -115,7 +150,9 @@ func runTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etlName
 	// or wait
 	var timeout time.Duration
 
-	fmt.Fprintf(c.App.Writer, tcbtcoCptn(text, bckFrom, bckTo)+" ...")
+	if !flagIsSet(c, jsonFlag) {
+		fmt.Fprintf(c.App.Writer, tcbtcoCptn(text, bckFrom, bckTo)+" ...")
+	}
 
 	if flagIsSet(c, waitJobXactFinishedFlag) {
 		timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
@@ -123,6 +160,8 @@ func runTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etlName
 	xargs := xact.ArgsMsg{ID: xid, Kind: xkind, Timeout: timeout}
 	if err = waitXact(&xargs); err != nil {
 		fmt.Fprintf(c.App.ErrWriter, fmtXactFailed, text, bckFrom, bckTo)
+	} else if etlName == "" {
+		err = copyRetry(c, bckFrom, bckTo, &msg.CopyBckMsg, &xargs)
 	} else {
 		fmt.Fprint(c.App.Writer, fmtXactSucceeded)
 	}
@@ -23,6 +23,7 @@ type (
 		barText string
 		total   int64
 		options []mpb.BarOption
+		speed   bool // show average throughput (sizeArg only)
 	}
 
 	// TODO: is obsolete (reimpl. via simpleBar)
@@ -52,12 +53,17 @@ func simpleBar(args ...barArgs) (progress *mpb.Progress, bars []*mpb.Bar) {
 		default:
 			debug.Assertf(false, "invalid argument: %s", a.barType)
 		}
+		appDecorators := []decor.Decorator{decor.Percentage(decor.WCSyncWidth)}
+		if a.speed {
+			debug.Assert(a.barType == sizeArg)
+			appDecorators = append(appDecorators, decor.AverageSpeed(decor.UnitKiB, "% .2f", decor.WCSyncWidth))
+		}
 		options := make([]mpb.BarOption, 0, len(a.options)+2)
 		options = append(options, a.options...)
 		options = append(
 			options,
 			mpb.PrependDecorators(argDecorators...),
-			mpb.AppendDecorators(decor.Percentage(decor.WCSyncWidth)),
+			mpb.AppendDecorators(appDecorators...),
 		)
 		bars = append(bars, progress.AddBar(a.total, options...))
 	}
@@ -16,6 +16,7 @@ var (
 	storageSvcCmdsFlags = map[string][]cli.Flag{
 		commandMirror: {
 			copiesFlag,
+			repairFlag,
 			nonverboseFlag,
 		},
 		commandECEncode: {
@@ -58,6 +59,19 @@ func setCopiesHandler(c *cli.Context) (err error) {
 		return
 	}
 
+	if flagIsSet(c, repairFlag) {
+		if flagIsSet(c, copiesFlag) {
+			return incorrectUsageMsg(c, "%s and %s are mutually exclusive", qflprn(repairFlag), qflprn(copiesFlag))
+		}
+		if !p.Mirror.Enabled || p.Mirror.Copies < 2 {
+			return fmt.Errorf("bucket %s is not mirrored, nothing to repair", bck.Cname(""))
+		}
+		return repairNCopies(c, bck, int(p.Mirror.Copies))
+	}
+	if !flagIsSet(c, copiesFlag) {
+		return missingArgumentsError(c, qflprn(copiesFlag))
+	}
+
 	copies := c.Int(copiesFlag.Name)
 	if p.Mirror.Copies == int64(copies) {
 		if copies > 1 && p.Mirror.Enabled {
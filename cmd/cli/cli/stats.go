@@ -25,8 +25,18 @@ import (
 	"github.com/urfave/cli"
 )
 
-// NOTE: target's metric names & kinds
-func getMetricNames(c *cli.Context) (cos.StrKVs, error) {
+// target's metric names & kinds rarely change - caching them
+// (keyed by cluster UUID) for the duration of `metricNamesTTL`
+const metricNamesTTL = 5 * time.Minute
+
+var curMetricNames struct {
+	kvs  cos.StrKVs
+	uuid string
+	ts   time.Time
+}
+
+// NOTE: target's metric names & kinds; `force` to refresh the cached ones
+func getMetricNames(c *cli.Context, force ...bool) (cos.StrKVs, error) {
 	smap, err := getClusterMap(c)
 	if err != nil {
 		return nil, err
@@ -34,11 +44,22 @@ func getMetricNames(c *cli.Context) (cos.StrKVs, error) {
 	if smap.CountActiveTs() == 0 {
 		return nil, nil
 	}
+	cached := &curMetricNames
+	if len(force) == 0 || !force[0] {
+		if cached.kvs != nil && cached.uuid == smap.UUID && time.Since(cached.ts) < metricNamesTTL {
+			return cached.kvs, nil
+		}
+	}
 	tsi, err := smap.GetRandTarget()
 	if err != nil {
 		return nil, err
 	}
-	return api.GetMetricNames(apiBP, tsi)
+	kvs, err := api.GetMetricNames(apiBP, tsi)
+	if err != nil {
+		return nil, err
+	}
+	cached.kvs, cached.uuid, cached.ts = kvs, smap.UUID, time.Now()
+	return kvs, nil
 }
 
 //
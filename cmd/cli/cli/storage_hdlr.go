@@ -321,10 +321,16 @@ func showDiskStats(c *cli.Context, tid string) error {
 			tally.Stat.WBps += ds.Stat.WBps
 			tally.Stat.Wavg += ds.Stat.Wavg
 			tally.Stat.Util += ds.Stat.Util
+			tally.Stat.Rlat += ds.Stat.Rlat
+			tally.Stat.Wlat += ds.Stat.Wlat
+			tally.Stat.Aqu += ds.Stat.Aqu
 		}
 		tally.Stat.Ravg = cos.DivRound(tally.Stat.Ravg, l)
 		tally.Stat.Wavg = cos.DivRound(tally.Stat.Wavg, l)
 		tally.Stat.Util = cos.DivRound(tally.Stat.Util, l)
+		tally.Stat.Rlat = cos.DivRound(tally.Stat.Rlat, l)
+		tally.Stat.Wlat = cos.DivRound(tally.Stat.Wlat, l)
+		tally.Stat.Aqu /= float64(l)
 
 		dsh = append(dsh, tally)
 	}
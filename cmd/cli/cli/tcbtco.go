@@ -5,16 +5,24 @@
 package cli
 
 import (
+	"bufio"
+	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/xact"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/urfave/cli"
@@ -65,7 +73,36 @@ func copyBucketHandler(c *cli.Context) (err error) {
 	}
 
 	// NOTE: copyAllObjsFlag forces 'x-list' to list the remote one, and vice versa
-	return copyTransform(c, "" /*etlName*/, objFrom, bckFrom, bckTo, flagIsSet(c, copyAllObjsFlag))
+	etlName := parseStrFlag(c, copyETLFlag)
+
+	// '--retries' requires waiting for completion
+	if flagIsSet(c, copyRetriesFlag) {
+		switch {
+		case parseIntFlag(c, copyRetriesFlag) < 0:
+			return incorrectUsageMsg(c, "%s must be non-negative", qflprn(copyRetriesFlag))
+		case !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag):
+			return incorrectUsageMsg(c, "%s requires %s (or %s)", qflprn(copyRetriesFlag), qflprn(waitFlag), qflprn(waitJobXactFinishedFlag))
+		case flagIsSet(c, progressFlag):
+			return incorrectUsageMsg(c, errFmtExclusive, qflprn(copyRetriesFlag), qflprn(progressFlag))
+		case flagIsSet(c, jsonFlag):
+			return incorrectUsageMsg(c, errFmtExclusive, qflprn(copyRetriesFlag), qflprn(jsonFlag))
+		case etlName != "":
+			return incorrectUsageMsg(c, errFmtExclusive, qflprn(copyRetriesFlag), qflprn(copyETLFlag))
+		}
+	}
+
+	// '--json' result requires waiting for completion
+	if flagIsSet(c, jsonFlag) {
+		switch {
+		case !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag):
+			return incorrectUsageMsg(c, "%s requires %s (or %s)", qflprn(jsonFlag), qflprn(waitFlag), qflprn(waitJobXactFinishedFlag))
+		case flagIsSet(c, progressFlag):
+			return incorrectUsageMsg(c, errFmtExclusive, qflprn(jsonFlag), qflprn(progressFlag))
+		case etlName != "":
+			return incorrectUsageMsg(c, errFmtExclusive, qflprn(jsonFlag), qflprn(copyETLFlag))
+		}
+	}
+	return copyTransform(c, etlName, objFrom, bckFrom, bckTo, flagIsSet(c, copyAllObjsFlag))
 }
 
 //
@@ -82,11 +119,29 @@ func copyTransform(c *cli.Context, etlName, objNameOrTmpl string, bckFrom, bckTo
 	if err != nil {
 		return err
 	}
+	if flagIsSet(c, copyFromFileFlag) {
+		if objName != "" || listObjs != "" || tmplObjs != "" {
+			return incorrectUsageMsg(c, "%s cannot be used together with object name, prefix, %s, or %s",
+				qflprn(copyFromFileFlag), qflprn(listFlag), qflprn(templateFlag))
+		}
+		if listObjs, err = readNamesFile(parseStrFlag(c, copyFromFileFlag)); err != nil {
+			return err
+		}
+	}
+	// validate template upfront (before HEAD-ing source and destination)
+	if tmplObjs != "" {
+		if _, err := cos.NewParsedTemplate(tmplObjs); err != nil && err != cos.ErrEmptyTemplate {
+			return fmt.Errorf("invalid %s %q: %v", qflprn(templateFlag), tmplObjs, err)
+		}
+	}
 
 	// HEAD(from)
-	if _, err = headBucket(bckFrom, true /* don't add */); err != nil {
+	if bckFrom.Props, err = headBucket(bckFrom, true /* don't add */); err != nil {
 		return err
 	}
+	if flagIsSet(c, latestVerFlag) && !bckFrom.IsRemote() {
+		return fmt.Errorf("%s requires remote source bucket (%s has no remote backend)", qflprn(latestVerFlag), bckFrom.Cname(""))
+	}
 	empty, err := isBucketEmpty(bckFrom, !bckFrom.IsRemote() || !allIncludingRemote /*cached*/)
 	debug.AssertNoErr(err)
 	if empty {
@@ -101,18 +156,53 @@ func copyTransform(c *cli.Context, etlName, objNameOrTmpl string, bckFrom, bckTo
 		return nil
 	}
 
+	// names from file: report (and exclude) those that are not present in the source
+	if flagIsSet(c, copyFromFileFlag) {
+		if listObjs, err = rmMissing(c, bckFrom, listObjs, allIncludingRemote); err != nil || listObjs == "" {
+			return err
+		}
+	}
+
 	// HEAD(to)
+	if err := checkDstBackend(c, bckTo); err != nil {
+		return err
+	}
 	if _, err = api.HeadBucket(apiBP, bckTo, true /* don't add */); err != nil {
-		if herr, ok := err.(*cmn.ErrHTTP); !ok || herr.Status != http.StatusNotFound {
+		herr, ok := err.(*cmn.ErrHTTP)
+		if !ok {
+			return err
+		}
+		switch {
+		case herr.Status == http.StatusUnauthorized || herr.Status == http.StatusForbidden:
+			return fmt.Errorf("access to destination %s denied (status %d): check %q backend credentials and permissions",
+				bckTo.Cname(""), herr.Status, bckTo.Provider)
+		case herr.Status != http.StatusNotFound:
 			return err
+		case bckTo.IsCloud():
+			return fmt.Errorf("destination %s does not exist (Cloud buckets must be created out of band)", bckTo.Cname(""))
+		}
+		var warn string
+		if bckFrom.IsAIS() || bckFrom.IsRemoteAIS() || flagIsSet(c, copyPropsFlag) {
+			warn = fmt.Sprintf("destination %s doesn't exist and will be created with configuration copied from the source (%s)",
+				bckTo, bckFrom)
+		} else {
+			warn = fmt.Sprintf("destination %s doesn't exist and will be created with default configuration", bckTo)
+			if c.Command.Name == commandCopy {
+				warn += fmt.Sprintf(" (tip: use %s to copy %s properties)", qflprn(copyPropsFlag), bckFrom)
+			}
 		}
-		warn := fmt.Sprintf("destination %s doesn't exist and will be created with configuration copied from the source (%s))",
-			bckTo, bckFrom)
 		actionWarn(c, warn)
 	}
 
 	dryRun := flagIsSet(c, copyDryRunFlag)
 
+	// large copies require confirmation
+	if !dryRun && !flagIsSet(c, yesFlag) {
+		if ok, err := confirmLarge(c, text1, bckFrom, bckTo, objName, listObjs, tmplObjs); !ok || err != nil {
+			return err
+		}
+	}
+
 	// either 1. copy/transform bucket (x-tcb)
 	if objName == "" && listObjs == "" && tmplObjs == "" {
 		// NOTE: e.g. 'ais cp gs://abc gs:/abc' to sync remote bucket => aistore
@@ -120,9 +210,11 @@ func copyTransform(c *cli.Context, etlName, objNameOrTmpl string, bckFrom, bckTo
 			return incorrectUsageMsg(c, errFmtSameBucket, commandCopy, bckTo)
 		}
 		if dryRun {
-			// TODO: show object names with destinations, make the output consistent with etl dry-run
 			dryRunCptn(c)
 			actionDone(c, text2+" the entire bucket")
+			if err := dryRunNames(c, bckFrom, bckTo, "", "", allIncludingRemote); err != nil {
+				return err
+			}
 		}
 		if etlName != "" {
 			return etlBucket(c, etlName, bckFrom, bckTo, allIncludingRemote)
@@ -141,20 +233,260 @@ func copyTransform(c *cli.Context, etlName, objNameOrTmpl string, bckFrom, bckTo
 		} else {
 			prompt = fmt.Sprintf("%s objects that match the pattern %q ...\n", text2, tmplObjs)
 		}
-		dryRunCptn(c) // TODO: ditto
+		dryRunCptn(c)
 		actionDone(c, prompt)
+		if err := dryRunNames(c, bckFrom, bckTo, listObjs, tmplObjs, allIncludingRemote); err != nil {
+			return err
+		}
 	}
 	return runTCO(c, bckFrom, bckTo, listObjs, tmplObjs, etlName)
 }
 
-func _iniCopyBckMsg(c *cli.Context, msg *apc.CopyBckMsg) (err error) {
+// read object names from file, one per line
+func readNamesFile(fname string) (string, error) {
+	fh, err := os.Open(fname)
+	if err != nil {
+		return "", err
+	}
+	defer fh.Close()
+
+	var (
+		names   []string
+		scanner = bufio.NewScanner(fh)
+	)
+	for scanner.Scan() {
+		name := strings.TrimSpace(scanner.Text())
+		if name == "" || name[0] == '#' {
+			continue
+		}
+		if strings.IndexByte(name, ',') >= 0 {
+			return "", fmt.Errorf("%s: object names containing commas are not supported (%q)", fname, name)
+		}
+		names = append(names, name)
+	}
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("failed to read %s: %v", fname, err)
+	}
+	if len(names) == 0 {
+		return "", fmt.Errorf("%s contains no object names", fname)
+	}
+	return strings.Join(uniqueNames(names), ","), nil
+}
+
+// HEAD source objects and remove those that don't exist
+func rmMissing(c *cli.Context, bckFrom cmn.Bck, listObjs string, allIncludingRemote bool) (string, error) {
+	var (
+		names       = splitCsv(listObjs)
+		found       = make([]string, 0, len(names))
+		missing     []string
+		fltPresence = apc.FltPresent
+	)
+	if bckFrom.IsRemote() && allIncludingRemote {
+		fltPresence = apc.FltExists
+	}
+	for _, name := range names {
+		if _, err := api.HeadObject(apiBP, bckFrom, name, fltPresence, true /*silent*/); err != nil {
+			if !cmn.IsStatusNotFound(err) {
+				return "", V(err)
+			}
+			missing = append(missing, name)
+			continue
+		}
+		found = append(found, name)
+	}
+	if len(missing) > 0 {
+		actionWarn(c, fmt.Sprintf("%d (out of %d) object%s not found in %s:", len(missing), len(names),
+			cos.Plural(len(missing)), bckFrom.Cname("")))
+		limitedLineWriter(c.App.Writer, dryRunExamplesCnt, "\t%s", missing)
+		if len(missing) > dryRunExamplesCnt {
+			fmt.Fprintf(c.App.Writer, "\t(and %d more)\n", len(missing)-dryRunExamplesCnt)
+		}
+	}
+	if len(found) == 0 {
+		actionNote(c, "nothing to do")
+	}
+	return strings.Join(found, ","), nil
+}
+
+// prompt to confirm copying (transforming) more than these
+const (
+	copyConfirmObjs = 10000
+	copyConfirmSize = 100 * cos.GiB
+)
+
+// estimate the number (and size) of source objects; prompt if exceeding either threshold
+func confirmLarge(c *cli.Context, verb string, bckFrom, bckTo cmn.Bck, objName, listObjs, tmplObjs string) (bool, error) {
+	var (
+		cnt, size int64
+		listed    bool
+		pt        cos.ParsedTemplate
+	)
+	if tmplObjs != "" {
+		pt, _ = cos.NewParsedTemplate(tmplObjs) // validated upfront
+	}
+	switch {
+	case listObjs != "":
+		cnt = int64(len(splitCsv(listObjs)))
+	case len(pt.Ranges) > 0:
+		cnt = pt.Count()
+	case bckFrom.IsRemote():
+		// not listing remote buckets (may take a while and/or cost money) - proceed without confirmation
+		return true, nil
+	default:
+		// entire bucket or prefix: list up to the threshold (plus one)
+		prefix := pt.Prefix
+		if objName != "" {
+			prefix = objName
+		}
+		msg := &apc.LsoMsg{Prefix: prefix, Props: apc.GetPropsSize}
+		msg.SetFlag(apc.LsNameSize)
+		lst, err := api.ListObjects(apiBP, bckFrom, msg, api.ListArgs{Limit: copyConfirmObjs + 1})
+		if err != nil {
+			return false, V(err)
+		}
+		cnt, listed = int64(len(lst.Entries)), true
+		for _, en := range lst.Entries {
+			size += en.Size
+		}
+	}
+	if cnt <= copyConfirmObjs && size <= copyConfirmSize {
+		return true, nil
+	}
+
+	estimate := fmt.Sprintf("%d objects", cnt)
+	if listed && cnt > copyConfirmObjs {
+		estimate = fmt.Sprintf("more than %d objects", copyConfirmObjs)
+	}
+	if size > 0 {
+		estimate += " (at least " + cos.ToSizeIEC(size, 1) + ")"
+	}
+	warn := fmt.Sprintf("about to %s %s from %s to %s (confirmation threshold: %d objects or %s)",
+		verb, estimate, bckFrom.Cname(""), bckTo.Cname(""), copyConfirmObjs, cos.ToSizeIEC(copyConfirmSize, 0))
+	if !confirm(c, "Proceed?", warn) {
+		return false, nil
+	}
+	return true, nil
+}
+
+// [DRY-RUN] show (up to dryRunExamplesCnt) source => destination object names
+func dryRunNames(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs string, allIncludingRemote bool) error {
+	var (
+		srcs []string
+		more string
+		pt   cos.ParsedTemplate
+	)
+	if tmplObjs != "" {
+		pt, _ = cos.NewParsedTemplate(tmplObjs) // validated upfront
+	}
+	switch {
+	case listObjs != "":
+		srcs = uniqueNames(splitCsv(listObjs))
+		if len(srcs) > dryRunExamplesCnt {
+			more = fmt.Sprintf("(and %d more)", len(srcs)-dryRunExamplesCnt)
+		}
+	case len(pt.Ranges) > 0:
+		srcs = pt.ToSlice(dryRunExamplesCnt)
+		if pt.Count() > dryRunExamplesCnt {
+			more = fmt.Sprintf("(and %d more)", pt.Count()-dryRunExamplesCnt)
+		}
+	default:
+		// entire bucket or prefix (ie., template with no ranges)
+		prefix := pt.Prefix
+		if prefix == "" {
+			prefix = parseStrFlag(c, verbObjPrefixFlag) // (entire bucket: same as CopyBckMsg.Prefix)
+		}
+		msg := &apc.LsoMsg{Prefix: prefix, PageSize: dryRunExamplesCnt}
+		msg.SetFlag(apc.LsNameOnly)
+		if bckFrom.IsRemote() && !allIncludingRemote {
+			msg.SetFlag(apc.LsObjCached)
+		}
+		lst, err := api.ListObjectsPage(apiBP, bckFrom, msg, api.ListArgs{})
+		if err != nil {
+			return V(err)
+		}
+		for _, en := range lst.Entries {
+			srcs = append(srcs, en.Name)
+		}
+		if lst.ContinuationToken != "" {
+			more = "(and more)"
+		}
+	}
+
+	var (
+		msg      apc.TCBMsg
+		selected = srcs[:0]
+		dsts     = make([]string, 0, len(srcs))
+	)
+	if err := _iniCopyBckMsg(c, &msg.CopyBckMsg, bckFrom); err != nil {
+		return err
+	}
+	for _, name := range srcs {
+		if msg.Selected(name) {
+			selected = append(selected, name)
+			dsts = append(dsts, msg.ToName(name))
+		}
+	}
+	srcs = selected
+	limitedLineWriter(c.App.Writer, dryRunExamplesCnt, bckFrom.Cname("")+"/%s => "+bckTo.Cname("")+"/%s", srcs, dsts)
+	if more != "" {
+		fmt.Fprintln(c.App.Writer, more)
+	}
+	return nil
+}
+
+// cross-provider copy (e.g., ais:// => s3://) requires destination backend to be configured
+func checkDstBackend(c *cli.Context, bckTo cmn.Bck) error {
+	if !bckTo.IsCloud() {
+		return nil
+	}
+	cfg, err := getRandTargetConfig(c)
+	if err != nil {
+		return err
+	}
+	if _, ok := cfg.Backend.Providers[bckTo.Provider]; !ok {
+		return fmt.Errorf("cannot copy to %s: cluster is not configured with %q backend", bckTo.Cname(""), bckTo.Provider)
+	}
+	return nil
+}
+
+func _iniCopyBckMsg(c *cli.Context, msg *apc.CopyBckMsg, bckFrom cmn.Bck) (err error) {
 	{
 		msg.Prepend = parseStrFlag(c, copyPrependFlag)
+		msg.StripPrefix = parseStrFlag(c, copyStripPrefixFlag)
 		msg.Prefix = parseStrFlag(c, verbObjPrefixFlag)
 		msg.DryRun = flagIsSet(c, copyDryRunFlag)
 		msg.Force = flagIsSet(c, forceFlag)
 		msg.LatestVer = flagIsSet(c, latestVerFlag)
 		msg.Sync = flagIsSet(c, syncFlag)
+		msg.SkipExisting = flagIsSet(c, copySkipExistingFlag)
+		msg.Overwrite = flagIsSet(c, copyOverwriteFlag)
+		msg.DeleteSrc = flagIsSet(c, copyDeleteSrcFlag)
+		msg.CloneProps = flagIsSet(c, copyPropsFlag)
+		msg.ContinueOnError = flagIsSet(c, continueOnErrorFlag)
+		msg.RenameMatch = parseStrFlag(c, copyRenameMatchFlag)
+		msg.RenameReplace = parseStrFlag(c, copyRenameReplaceFlag)
+		msg.Include, msg.Exclude = _globs(c)
+		if flagIsSet(c, copyNumWorkersFlag) {
+			msg.NumWorkers = parseIntFlag(c, copyNumWorkersFlag)
+		}
+		if msg.BwLimit, err = _sizeFlag(c, copyBwLimitFlag); err != nil {
+			return err
+		}
+		if msg.WindowSize, err = _sizeFlag(c, copyWindowFlag); err != nil {
+			return err
+		}
+	}
+	if err = _checkSkipExisting(msg, bckFrom); err != nil {
+		return err
+	}
+	if err = msg.ValidateLimits(); err != nil {
+		return err // (names the offending flag)
+	}
+	if err = msg.InitRename(); err != nil {
+		return err
+	}
+	if err = msg.ValidateGlobs(); err != nil {
+		return err
 	}
 	if msg.Sync && msg.Prepend != "" {
 		err = fmt.Errorf("prepend option (%q) is incompatible with %s (the latter requires identical source/destination naming)",
@@ -163,6 +495,45 @@ func _iniCopyBckMsg(c *cli.Context, msg *apc.CopyBckMsg) (err error) {
 	return err
 }
 
+// '--include' and '--exclude' glob patterns
+func _globs(c *cli.Context) (include, exclude []string) {
+	if flagIsSet(c, copyIncludeFlag) {
+		include = splitCsv(parseStrFlag(c, copyIncludeFlag))
+	}
+	if flagIsSet(c, copyExcludeFlag) {
+		exclude = splitCsv(parseStrFlag(c, copyExcludeFlag))
+	}
+	return include, exclude
+}
+
+// (bw-limit, window-size)
+func _sizeFlag(c *cli.Context, flag cli.StringFlag) (int64, error) {
+	if !flagIsSet(c, flag) {
+		return 0, nil
+	}
+	size, err := parseSizeFlag(c, flag)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %v", qflprn(flag), err)
+	}
+	return size, nil
+}
+
+func _checkSkipExisting(msg *apc.CopyBckMsg, bckFrom cmn.Bck) error {
+	if msg.SkipExisting && (msg.Overwrite || msg.LatestVer || msg.Sync) {
+		return fmt.Errorf("%s is incompatible with %s, %s, and %s",
+			qflprn(copySkipExistingFlag), qflprn(copyOverwriteFlag), qflprn(latestVerFlag), qflprn(syncFlag))
+	}
+	if msg.DeleteSrc && (msg.SkipExisting || msg.Sync) {
+		return fmt.Errorf("%s is incompatible with %s and %s",
+			qflprn(copyDeleteSrcFlag), qflprn(copySkipExistingFlag), qflprn(syncFlag))
+	}
+	if msg.DeleteSrc && bckFrom.IsRemote() {
+		return fmt.Errorf("%s requires ais:// source bucket (have %s) - won't delete from remote backend",
+			qflprn(copyDeleteSrcFlag), bckFrom.Cname(""))
+	}
+	return nil
+}
+
 func copyBucket(c *cli.Context, bckFrom, bckTo cmn.Bck, allIncludingRemote bool) error {
 	var (
 		msg          apc.CopyBckMsg
@@ -174,8 +545,13 @@ func copyBucket(c *cli.Context, bckFrom, bckTo cmn.Bck, allIncludingRemote bool)
 		actionWarn(c, warn)
 		showProgress = false
 	}
+	// '--wait' in a terminal implies live progress (unless non-verbose)
+	if !showProgress && flagIsSet(c, waitFlag) && !flagIsSet(c, copyDryRunFlag) && !flagIsSet(c, nonverboseFlag) &&
+		!flagIsSet(c, jsonFlag) && !flagIsSet(c, copyRetriesFlag) {
+		showProgress = isTerminal(c)
+	}
 	// copy: with/wo progress/wait
-	if err := _iniCopyBckMsg(c, &msg); err != nil {
+	if err := _iniCopyBckMsg(c, &msg, bckFrom); err != nil {
 		return err
 	}
 
@@ -221,16 +597,212 @@ func copyBucket(c *cli.Context, bckFrom, bckTo cmn.Bck, allIncludingRemote bool)
 	if flagIsSet(c, waitJobXactFinishedFlag) {
 		timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
 	}
-	fmt.Fprintf(c.App.Writer, tcbtcoCptn("Copying", bckFrom, bckTo)+" ...")
+	if !flagIsSet(c, jsonFlag) {
+		fmt.Fprintf(c.App.Writer, tcbtcoCptn("Copying", bckFrom, bckTo)+" ...")
+	}
 	xargs := xact.ArgsMsg{ID: xid, Kind: kind, Timeout: timeout}
 	if err := waitXact(&xargs); err != nil {
 		fmt.Fprintf(c.App.ErrWriter, fmtXactFailed, "copy", from, to)
 		return err
 	}
-	actionDone(c, fmtXactSucceeded)
+	return copyRetry(c, bckFrom, bckTo, &msg, &xargs)
+}
+
+// show copy summary and, if requested, resubmit failed objects (via x-tco)
+func copyRetry(c *cli.Context, bckFrom, bckTo cmn.Bck, cpmsg *apc.CopyBckMsg, xargs *xact.ArgsMsg) error {
+	err := copySummary(c, xargs)
+	retries := parseIntFlag(c, copyRetriesFlag)
+	for i := 1; err != nil && i <= retries; i++ {
+		names, cnt, errV := copyErrNames(xargs)
+		if errV != nil {
+			return errV
+		}
+		if len(names) == 0 {
+			break // nothing to retry
+		}
+		note := fmt.Sprintf("retrying %d failed object%s (attempt %d of %d)", len(names), cos.Plural(len(names)), i, retries)
+		if cnt > int64(len(names)) {
+			note += fmt.Sprintf(" - note: %d failures in total, the rest are not retried", cnt)
+		}
+		actionNote(c, note)
+
+		msg := cmn.TCObjsMsg{ToBck: bckTo}
+		{
+			msg.CopyBckMsg = *cpmsg
+			msg.Prefix = ""
+			msg.ObjNames = names
+		}
+		xid, errV := api.CopyMultiObj(apiBP, bckFrom, &msg)
+		if errV != nil {
+			return V(errV)
+		}
+		xargs = &xact.ArgsMsg{ID: xid, Kind: apc.ActCopyObjects, Timeout: xargs.Timeout}
+		fmt.Fprintf(c.App.Writer, tcbtcoCptn("Copying", bckFrom, bckTo)+" ...")
+		if err = waitXact(xargs); err != nil {
+			fmt.Fprintf(c.App.ErrWriter, fmtXactFailed, "copy", bckFrom.Cname(""), bckTo.Cname(""))
+			return err
+		}
+		err = copySummary(c, xargs)
+	}
+	return err
+}
+
+// names of the source objects that failed to copy (summed up across all targets), and the total count
+func copyErrNames(xargs *xact.ArgsMsg) (names []string, cnt int64, err error) {
+	snaps, err := api.QueryXactionSnaps(apiBP, xargs)
+	if err != nil {
+		return nil, 0, V(err)
+	}
+	if cnt = copyExtCnt(snaps, xargs.ID, "err.n"); cnt == 0 {
+		return nil, 0, nil
+	}
+	// (snapshots carry only a few - query the full lists)
+	all, err := api.GetXactErrNames(apiBP, xargs.ID)
+	if err != nil {
+		return nil, 0, V(err)
+	}
+	for _, tnames := range all {
+		names = append(names, tnames...)
+	}
+	return uniqueNames(names), cnt, nil
+}
+
+// '--json' copy result: xaction snapshot (see core.Snap) summed up across all targets
+type copyResult struct {
+	core.Snap
+	Elapsed cos.Duration `json:"elapsed"`
+}
+
+// upon successful '--wait' completion: show copy summary
+// (x-tcb or x-tco, summed up across all targets)
+func copySummary(c *cli.Context, xargs *xact.ArgsMsg) error {
+	usejs := flagIsSet(c, jsonFlag)
+	snaps, err := api.QueryXactionSnaps(apiBP, xargs)
+	if err != nil {
+		if usejs {
+			return V(err)
+		}
+		actionDone(c, fmtXactSucceeded)
+		return nil
+	}
+	var (
+		locObjs, _, _  = snaps.ObjCounts(xargs.ID)
+		locBytes, _, _ = snaps.ByteCounts(xargs.ID)
+		skipped        = copyExtCnt(snaps, xargs.ID, "skip.n")
+		failed         = copyExtCnt(snaps, xargs.ID, "err.n")
+		errs           = copyErrs(snaps, xargs.ID)
+		elapsed        time.Duration
+	)
+	if xact.IsValidUUID(xargs.ID) {
+		elapsed, _ = snaps.TotalRunningTime(xargs.ID)
+	}
+
+	if usejs {
+		res := copyResult{Elapsed: cos.Duration(elapsed)}
+		copyResSnap(&res.Snap, snaps, xargs.ID)
+		res.Ext = cos.StrKVs{"skip.n": strconv.FormatInt(skipped, 10), "err.n": strconv.FormatInt(failed, 10)}
+		res.Err = strings.Join(errs, "\n")
+		out, err := jsonMarshalIndent(&res)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(c.App.Writer, string(out))
+	} else {
+		fmt.Fprint(c.App.Writer, fmtXactSucceeded)
+		nvs := nvpairList{
+			{"objects", strconv.FormatInt(locObjs, 10)},
+			{"size", cos.ToSizeIEC(locBytes, 2)},
+			{"skipped", strconv.FormatInt(skipped, 10)},
+		}
+		if elapsed > 0 {
+			bps := int64(float64(locBytes) / elapsed.Seconds())
+			nvs = append(nvs,
+				nvpair{"elapsed", teb.FormatDuration(elapsed)},
+				nvpair{"throughput", cos.ToSizeIEC(bps, 2) + "/s"},
+			)
+		}
+		nvs = append(nvs, nvpair{"errors", strconv.FormatInt(failed, 10)})
+		teb.Print(nvs, teb.PropValTmpl)
+		for _, e := range errs {
+			actionWarn(c, e)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to copy %d object%s", failed, cos.Plural(int(failed)))
+	}
+	if len(errs) > 0 {
+		return errors.New("copy completed with errors")
+	}
 	return nil
 }
 
+// sum up stats counters; start (end) time is the earliest (latest) across targets
+func copyResSnap(res *core.Snap, snaps xact.MultiSnap, xid string) {
+	res.ID = xid
+	for _, tsnaps := range snaps {
+		for _, snap := range tsnaps {
+			if snap.ID != xid {
+				continue
+			}
+			res.Kind, res.SrcBck, res.DstBck = snap.Kind, snap.SrcBck, snap.DstBck
+			if res.StartTime.IsZero() || snap.StartTime.Before(res.StartTime) {
+				res.StartTime = snap.StartTime
+			}
+			if snap.EndTime.After(res.EndTime) {
+				res.EndTime = snap.EndTime
+			}
+			res.Stats.Objs += snap.Stats.Objs
+			res.Stats.Bytes += snap.Stats.Bytes
+			res.Stats.OutObjs += snap.Stats.OutObjs
+			res.Stats.OutBytes += snap.Stats.OutBytes
+			res.Stats.InObjs += snap.Stats.InObjs
+			res.Stats.InBytes += snap.Stats.InBytes
+			if snap.AbortErr != "" {
+				res.AbortErr = snap.AbortErr
+			}
+			res.AbortedX = res.AbortedX || snap.AbortedX
+		}
+	}
+}
+
+// per-target errors, if any (one per line)
+func copyErrs(snaps xact.MultiSnap, xid string) (errs []string) {
+	for tid, tsnaps := range snaps {
+		for _, snap := range tsnaps {
+			if snap.ID != xid || snap.Err == "" {
+				continue
+			}
+			for _, line := range strings.Split(snap.Err, "\n") {
+				errs = append(errs, meta.Tname(tid)+": "+line)
+			}
+		}
+	}
+	sort.Strings(errs)
+	return errs
+}
+
+// x-tcb and x-tco extended stats: total number of skipped ("skip.n") or failed ("err.n") objects
+func copyExtCnt(snaps xact.MultiSnap, xid, name string) (n int64) {
+	for _, tsnaps := range snaps {
+		for _, snap := range tsnaps {
+			if snap.ID != xid {
+				continue
+			}
+			ext, ok := snap.Ext.(map[string]any)
+			if !ok {
+				continue
+			}
+			if v, ok := ext[name].(string); ok {
+				if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+					n += i
+				}
+			}
+		}
+	}
+	return n
+}
+
 func tcbtcoCptn(action string, bckFrom, bckTo cmn.Bck) string {
 	from, to := bckFrom.Cname(""), bckTo.Cname("")
 	if bckFrom.Equal(&bckTo) {
@@ -259,7 +831,7 @@ func etlBucket(c *cli.Context, etlName string, bckFrom, bckTo cmn.Bck, allInclud
 	var msg = apc.TCBMsg{
 		Transform: apc.Transform{Name: etlName},
 	}
-	if err := _iniCopyBckMsg(c, &msg.CopyBckMsg); err != nil {
+	if err := _iniCopyBckMsg(c, &msg.CopyBckMsg, bckFrom); err != nil {
 		return err
 	}
 	if flagIsSet(c, etlExtFlag) {
@@ -192,6 +192,22 @@ func splitCsv(s string) (lst []string) {
 	return
 }
 
+// remove empty and duplicate names, preserving the original order
+func uniqueNames(names []string) []string {
+	var (
+		out  = names[:0]
+		seen = make(cos.StrSet, len(names))
+	)
+	for _, name := range names {
+		if name == "" || seen.Contains(name) {
+			continue
+		}
+		seen.Add(name)
+		out = append(out, name)
+	}
+	return out
+}
+
 // Convert a list of "key value" and "key=value" pairs into a map
 func makePairs(args []string) (nvs cos.StrKVs, err error) {
 	var (
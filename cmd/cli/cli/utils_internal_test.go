@@ -292,6 +292,24 @@ func TestMakePairsErrors(t *testing.T) {
 	}
 }
 
+func TestUniqueNames(t *testing.T) {
+	uniqueNamesTests := []struct {
+		input    string
+		expected []string
+	}{
+		{"a, b, c", []string{"a", "b", "c"}},
+		{"a,b,a, c,b", []string{"a", "b", "c"}},
+		{"a,,b, ,", []string{"a", "b"}},
+		{" , ,", []string{}},
+	}
+	for _, test := range uniqueNamesTests {
+		names := uniqueNames(splitCsv(test.input))
+		if !reflect.DeepEqual(names, test.expected) {
+			t.Errorf("uniqueNames(%q): expected %v, got %v", test.input, test.expected, names)
+		}
+	}
+}
+
 func TestParseQueryBckURI(t *testing.T) {
 	positiveTests := []struct {
 		uri string
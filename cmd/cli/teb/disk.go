@@ -6,6 +6,7 @@ package teb
 
 import (
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/NVIDIA/aistore/core/meta"
@@ -19,6 +20,9 @@ const (
 	colWrite    = "WRITE"
 	colWriteAvg = "WRITE(avg size)"
 	colUtil     = "UTIL(%)"
+	colReadLat  = "READ(avg latency)"
+	colWriteLat = "WRITE(avg latency)"
+	colQueue    = "QUEUE(avg size)"
 )
 
 func NewDiskTab(dsh []DiskStatsHelper, smap *meta.Smap, regex *regexp.Regexp, units, totalsHdr string) *Table {
@@ -32,6 +36,13 @@ func NewDiskTab(dsh []DiskStatsHelper, smap *meta.Smap, regex *regexp.Regexp, un
 		{name: colWriteAvg},
 		{name: colUtil},
 	}
+	// (not every drive and OS provides these)
+	for _, ds := range dsh {
+		if ds.Stat.Rlat != 0 || ds.Stat.Wlat != 0 || ds.Stat.Aqu != 0 {
+			cols = append(cols, &header{name: colReadLat}, &header{name: colWriteLat}, &header{name: colQueue})
+			break
+		}
+	}
 	if regex != nil {
 		cols = _flt(cols, regex)
 	}
@@ -63,6 +74,15 @@ func NewDiskTab(dsh []DiskStatsHelper, smap *meta.Smap, regex *regexp.Regexp, un
 		if _idx(cols, colUtil) >= 0 {
 			row = append(row, FmtStatValue("", "", stat.Util, units)+"%")
 		}
+		if _idx(cols, colReadLat) >= 0 {
+			row = append(row, FmtStatValue("", stats.KindLatency, stat.Rlat, units))
+		}
+		if _idx(cols, colWriteLat) >= 0 {
+			row = append(row, FmtStatValue("", stats.KindLatency, stat.Wlat, units))
+		}
+		if _idx(cols, colQueue) >= 0 {
+			row = append(row, strconv.FormatFloat(stat.Aqu, 'f', 2, 64))
+		}
 
 		if ds.TargetID == totalsHdr {
 			row[len(row)-1] += fcyan(" ---")
@@ -6,6 +6,7 @@
 package cmn
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"net/url"
@@ -242,6 +243,21 @@ func ParseUname(uname string) (b Bck, objName string) {
 	return
 }
 
+// unique name encoded (reversibly) to be placed in URL path without escaping
+// (use DecodeUname to translate back)
+func (b *Bck) EncodeUname(objName string) string {
+	return base64.RawURLEncoding.EncodeToString(cos.UnsafeB(b.MakeUname(objName)))
+}
+
+func DecodeUname(s string) (b Bck, objName string, err error) {
+	var uname []byte
+	if uname, err = base64.RawURLEncoding.DecodeString(s); err != nil {
+		return
+	}
+	b, objName = ParseUname(cos.UnsafeS(uname))
+	return
+}
+
 /////////
 // Bck (ref)
 /////////
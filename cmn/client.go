@@ -26,6 +26,7 @@ type (
 		IdleConnTimeout  time.Duration
 		IdleConnsPerHost int
 		MaxIdleConns     int
+		MaxConnsPerHost  int // zero: unlimited
 		SndRcvBufSize    int
 		WriteBufferSize  int
 		ReadBufferSize   int
@@ -64,6 +65,7 @@ func NewTransport(cargs TransportArgs) *http.Transport {
 		IdleConnTimeout:       cargs.IdleConnTimeout,
 		MaxIdleConnsPerHost:   cargs.IdleConnsPerHost,
 		MaxIdleConns:          cargs.MaxIdleConns,
+		MaxConnsPerHost:       cargs.MaxConnsPerHost,
 		WriteBufferSize:       cargs.WriteBufferSize,
 		ReadBufferSize:        cargs.ReadBufferSize,
 		DisableCompression:    true, // NOTE: hardcoded - never used
@@ -58,6 +58,7 @@ type (
 		HostNet   LocalNetConfig `json:"host_net"`
 		FSP       FSPConf        `json:"fspaths"`
 		TestFSP   TestFSPConf    `json:"test_fspaths"`
+		Caps      []string       `json:"capabilities,omitempty"` // apc.NodeCapETL, et al.
 	}
 
 	// ais node: (local) network config
@@ -179,14 +180,31 @@ type (
 	BackendConfAIS map[string][]string // cluster alias -> [urls...]
 
 	MirrorConf struct {
-		Copies  int64 `json:"copies"`       // num copies
-		Burst   int   `json:"burst_buffer"` // xaction channel (buffer) size
-		Enabled bool  `json:"enabled"`      // enabled (to generate copies)
+		Placement string `json:"placement,omitempty"` // mountpath selection strategy (enum SupportedPlacements below)
+		Label     string `json:"label,omitempty"`     // preferred mountpath label (storage class, e.g. "ssd"), if any
+		LruWM     int64  `json:"lruwm,omitempty"`     // deprioritize mountpaths with used capacity (%) at or above (0: space.lowwm)
+		InodesWM  int64  `json:"inodeswm,omitempty"`  // skip mountpaths with free inodes (%) below (0: DefaultMirrorInodesWM)
+		Affinity  string `json:"affinity,omitempty"`  // write-affinity hint: place copies near or far from the main replica (enum SupportedAffinities)
+		Copies    int64  `json:"copies"`              // num copies
+		Burst     int    `json:"burst_buffer"`        // xaction channel (buffer) size
+		Enabled   bool   `json:"enabled"`             // enabled (to generate copies)
+
+		// mirroring in controlled bursts: upon each BatchSize objects, a given
+		// mountpath worker pauses for BatchPause (both zero - no pausing)
+		BatchSize  int          `json:"batch_size,omitempty"`
+		BatchPause cos.Duration `json:"batch_pause,omitempty"`
 	}
 	MirrorConfToSet struct {
-		Copies  *int64 `json:"copies,omitempty"`
-		Burst   *int   `json:"burst_buffer,omitempty"`
-		Enabled *bool  `json:"enabled,omitempty"`
+		Placement  *string       `json:"placement,omitempty"`
+		Label      *string       `json:"label,omitempty"`
+		LruWM      *int64        `json:"lruwm,omitempty"`
+		InodesWM   *int64        `json:"inodeswm,omitempty"`
+		Affinity   *string       `json:"affinity,omitempty"`
+		Copies     *int64        `json:"copies,omitempty"`
+		Burst      *int          `json:"burst_buffer,omitempty"`
+		Enabled    *bool         `json:"enabled,omitempty"`
+		BatchSize  *int          `json:"batch_size,omitempty"`
+		BatchPause *cos.Duration `json:"batch_pause,omitempty"`
 	}
 
 	ECConf struct {
@@ -318,12 +336,22 @@ type (
 		// Out-of-Space: if exceeded, the target starts failing new PUTs and keeps
 		// failing them until its local used-cap gets back below HighWM (see above)
 		OOS int64 `json:"out_of_space"`
+
+		// WorkfileTTL: upon target restart, remove workfiles left behind by the previous
+		// run and not modified for at least this long (zero: fs.DfltWorkfileTTL)
+		WorkfileTTL cos.Duration `json:"workfile_ttl,omitempty"`
+
+		// MptUploadTTL: S3 multipart uploads inactive for at least this long get aborted,
+		// and their parts removed (zero: 24h)
+		MptUploadTTL cos.Duration `json:"mpt_upload_ttl,omitempty"`
 	}
 	SpaceConfToSet struct {
-		CleanupWM *int64 `json:"cleanupwm,omitempty"`
-		LowWM     *int64 `json:"lowwm,omitempty"`
-		HighWM    *int64 `json:"highwm,omitempty"`
-		OOS       *int64 `json:"out_of_space,omitempty"`
+		CleanupWM    *int64        `json:"cleanupwm,omitempty"`
+		LowWM        *int64        `json:"lowwm,omitempty"`
+		HighWM       *int64        `json:"highwm,omitempty"`
+		OOS          *int64        `json:"out_of_space,omitempty"`
+		WorkfileTTL  *cos.Duration `json:"workfile_ttl,omitempty"`
+		MptUploadTTL *cos.Duration `json:"mpt_upload_ttl,omitempty"`
 	}
 
 	LRUConf struct {
@@ -463,6 +491,10 @@ type (
 		UseHTTPS        bool   `json:"use_https"`         // use HTTPS
 		SkipVerifyCrt   bool   `json:"skip_verify"`       // skip X509 cert verification (used with self-signed certs)
 		Chunked         bool   `json:"chunked_transfer"`  // (https://tools.ietf.org/html/rfc7230#page-36; not used since 02/23)
+		// TLS for intra-cluster data (transport streams, target-to-target copies, etc.) even when
+		// not UseHTTPS: same cert/key; peers verified against client_ca_tls (the cluster CA);
+		// requires separate intra-data network (see LocalNetConfig)
+		IntraDataTLS bool `json:"intra_data_tls"`
 	}
 	HTTPConfToSet struct {
 		Certificate     *string `json:"server_crt,omitempty"`
@@ -475,6 +507,7 @@ type (
 		UseHTTPS        *bool   `json:"use_https,omitempty"`
 		SkipVerifyCrt   *bool   `json:"skip_verify,omitempty"`
 		Chunked         *bool   `json:"chunked_transfer,omitempty"`
+		IntraDataTLS    *bool   `json:"intra_data_tls,omitempty"`
 	}
 
 	FSHCConf struct {
@@ -621,6 +654,26 @@ const (
 
 var SupportedReactions = []string{IgnoreReaction, WarnReaction, AbortReaction}
 
+// mirror.placement: how to select mountpath for the next copy
+const (
+	PlacementLeastUtil = "least_util" // least utilized (default)
+	PlacementCapacity  = "capacity"   // lowest used capacity (fill ratio) - balances heterogeneous disks
+)
+
+var SupportedPlacements = []string{PlacementLeastUtil, PlacementCapacity}
+
+// mirror.affinity: where to place copies relative to the main replica - by (user-assigned) mountpath label
+// rather than the actual disk topology; empty - spread by utilization (default)
+const (
+	AffinityNear = "near" // prefer mountpaths with the same label
+	AffinityFar  = "far"  // prefer mountpaths with a different label
+)
+
+var SupportedAffinities = []string{AffinityNear, AffinityFar}
+
+// mirror.inodeswm: default min free inodes (%) - see MirrorConf.MinFreeInodes
+const DefaultMirrorInodesWM = 2
+
 //
 // config meta-versioning & serialization
 //
@@ -1013,6 +1066,12 @@ func (c *SpaceConf) Validate() (err error) {
 	if c.CleanupWM <= 0 || c.LowWM < c.CleanupWM || c.HighWM < c.LowWM || c.OOS < c.HighWM || c.OOS > 100 {
 		err = fmt.Errorf("invalid %s (expecting: 0 < cleanup < low < high < OOS < 100)", c)
 	}
+	if c.WorkfileTTL < 0 {
+		err = fmt.Errorf("invalid space.workfile_ttl=%v (expecting non-negative)", c.WorkfileTTL)
+	}
+	if c.MptUploadTTL < 0 {
+		err = fmt.Errorf("invalid space.mpt_upload_ttl=%v (expecting non-negative)", c.MptUploadTTL)
+	}
 	return
 }
 
@@ -1114,9 +1173,36 @@ func (c *MirrorConf) Validate() error {
 	if c.Copies < 2 || c.Copies > 32 {
 		return fmt.Errorf("invalid mirror.copies: %d (expected value in range [2, 32])", c.Copies)
 	}
+	if c.BatchSize < 0 || c.BatchPause < 0 {
+		return fmt.Errorf("invalid mirror.batch_size (%d) and/or mirror.batch_pause (%v)", c.BatchSize, c.BatchPause)
+	}
+	if c.LruWM < 0 || c.LruWM > 100 {
+		return fmt.Errorf("invalid mirror.lruwm: %d (expected value in range [0, 100])", c.LruWM)
+	}
+	if c.InodesWM < 0 || c.InodesWM > 100 {
+		return fmt.Errorf("invalid mirror.inodeswm: %d (expected value in range [0, 100])", c.InodesWM)
+	}
+	if c.Affinity != "" && !cos.StringInSlice(c.Affinity, SupportedAffinities) {
+		return fmt.Errorf("invalid mirror.affinity: %q (expecting one of %v or empty)", c.Affinity, SupportedAffinities)
+	}
+	if c.Placement != "" && !cos.StringInSlice(c.Placement, SupportedPlacements) {
+		return fmt.Errorf("invalid mirror.placement: %q (expecting one of %v)", c.Placement, SupportedPlacements)
+	}
 	return nil
 }
 
+// min free inodes (%) for a mountpath to be selected for a new copy
+func (c *MirrorConf) MinFreeInodes() int32 {
+	if c.InodesWM == 0 {
+		return DefaultMirrorInodesWM
+	}
+	return int32(c.InodesWM)
+}
+
+func (c *MirrorConf) CapacityPlacement() bool { return c.Placement == PlacementCapacity }
+
+func (c *MirrorConf) Batching() bool { return c.BatchSize > 0 && c.BatchPause > 0 }
+
 func (c *MirrorConf) ValidateAsProps(...any) error {
 	if !c.Enabled {
 		return nil
@@ -1273,6 +1359,16 @@ func (c *HTTPConf) Validate() error {
 	return nil
 }
 
+// whether intra-cluster data traffic is TLS-encrypted
+func (c *HTTPConf) UseDataTLS() bool { return c.UseHTTPS || c.IntraDataTLS }
+
+func (c *HTTPConf) DataProto() string {
+	if c.UseDataTLS() {
+		return "https"
+	}
+	return "http"
+}
+
 // used intra-clients; see related: EnvToTLS()
 func (c *HTTPConf) ToTLS() TLSArgs {
 	return TLSArgs{
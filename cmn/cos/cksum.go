@@ -60,6 +60,10 @@ type (
 		CksumHash
 		Size int64
 	}
+	// MultiCksumHash computes several checksums (of different types) in one pass
+	MultiCksumHash struct {
+		hashes []*CksumHash
+	}
 )
 
 var checksums = StrSet{
@@ -78,6 +82,7 @@ var (
 	_ encoding.BinaryUnmarshaler = (*noopHash)(nil)
 
 	_ io.Writer = (*CksumHashSize)(nil)
+	_ io.Writer = (*MultiCksumHash)(nil)
 )
 
 var NoneCksum = NewCksum(ChecksumNone, "")
@@ -141,6 +146,47 @@ func (ck *CksumHashSize) Write(b []byte) (n int, err error) {
 	return
 }
 
+////////////////////
+// MultiCksumHash //
+////////////////////
+
+// (ChecksumNone and duplicate types are skipped)
+func NewMultiCksumHash(types ...string) *MultiCksumHash {
+	mh := &MultiCksumHash{hashes: make([]*CksumHash, 0, len(types))}
+	for _, ty := range types {
+		if ty == "" || ty == ChecksumNone || mh.Get(ty) != nil {
+			continue
+		}
+		mh.hashes = append(mh.hashes, NewCksumHash(ty))
+	}
+	return mh
+}
+
+func (mh *MultiCksumHash) Write(b []byte) (n int, err error) {
+	for _, ck := range mh.hashes {
+		if n, err = ck.H.Write(b); err != nil {
+			return n, err
+		}
+	}
+	return len(b), nil
+}
+
+func (mh *MultiCksumHash) Finalize() {
+	for _, ck := range mh.hashes {
+		ck.Finalize()
+	}
+}
+
+// returns nil if not present
+func (mh *MultiCksumHash) Get(ty string) *CksumHash {
+	for _, ck := range mh.hashes {
+		if ck.ty == ty {
+			return ck
+		}
+	}
+	return nil
+}
+
 ///////////
 // Cksum //
 ///////////
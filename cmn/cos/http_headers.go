@@ -13,6 +13,7 @@ const (
 	ContentMsgPack        = "application/msgpack"
 	ContentXML            = "application/xml"
 	ContentBinary         = "application/octet-stream"
+	ContentText           = "text/plain; charset=utf-8"
 
 	// not currently used:
 	ContentZip = "application/zip"
@@ -33,13 +34,21 @@ const (
 	HdrContentType        = "Content-Type"
 	HdrContentTypeOptions = "X-Content-Type-Options"
 	HdrContentLength      = "Content-Length"
+	HdrContentEncoding    = "Content-Encoding"
 
 	// misc. gen
-	HdrUserAgent = "User-Agent"
-	HdrAccept    = "Accept"
-	HdrLocation  = "Location"
-	HdrServer    = "Server"
-	HdrETag      = "ETag" // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/ETag
+	HdrUserAgent      = "User-Agent"
+	HdrAccept         = "Accept"
+	HdrAcceptEncoding = "Accept-Encoding"
+	HdrLocation       = "Location"
+	HdrServer         = "Server"
+	HdrETag           = "ETag" // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/ETag
+
+	// conditional requests (Ref: https://www.rfc-editor.org/rfc/rfc7232)
+	HdrIfMatch           = "If-Match"
+	HdrIfNoneMatch       = "If-None-Match"
+	HdrIfModifiedSince   = "If-Modified-Since"
+	HdrIfUnmodifiedSince = "If-Unmodified-Since"
 )
 
 //
@@ -59,15 +68,19 @@ const (
 	S3VersionHeader = "x-amz-version-id"
 
 	// s3 api request headers
-	S3HdrObjSrc = "x-amz-copy-source"
-	S3HdrMptCnt = "x-amz-mp-parts-count"
+	S3HdrObjSrc      = "x-amz-copy-source"
+	S3HdrObjSrcRange = "x-amz-copy-source-range" // UploadPartCopy: "bytes=first-last"
+	S3HdrMptCnt      = "x-amz-mp-parts-count"
 
 	// https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
 	S3UnsignedPayload  = "UNSIGNED-PAYLOAD"
 	S3HdrContentSHA256 = "x-amz-content-sha256"
 
+	S3HdrContentMD5 = "Content-MD5" // base64-encoded (RFC 1864)
+
 	S3HdrBckRegion = "x-amz-bucket-region"
 
+	S3HdrCksumAlgo   = "x-amz-checksum-algorithm" // CreateMultipartUpload: part checksum (see also "x-amz-checksum-*")
 	S3ChecksumCRC32  = "x-amz-checksum-crc32"
 	S3ChecksumCRC32C = "x-amz-checksum-crc32c"
 	S3ChecksumSHA1   = "x-amz-checksum-sha1"
@@ -30,6 +30,8 @@ const (
 
 const ContentLengthUnknown = -1
 
+var ErrMaxBytes = errors.New("exceeded max bytes")
+
 const PathSeparator = string(filepath.Separator)
 
 // readers
@@ -48,6 +50,11 @@ type (
 		io.ReadCloser
 		Size() int64
 	}
+	// ReadCloseSizeSeeker is ReadCloseSizer that can also seek (e.g., to read a byte range).
+	ReadCloseSizeSeeker interface {
+		ReadCloseSizer
+		io.Seeker
+	}
 	// ReadOpenCloseSizer is the interface that adds Size method to ReadOpenCloser.
 	ReadOpenCloseSizer interface {
 		ReadOpenCloser
@@ -83,13 +90,25 @@ type (
 		reportedBytes int
 	}
 	ReaderArgs struct {
-		R       io.Reader
-		ReadCb  func(int, error)
-		DeferCb func()
-		Size    int64
+		R      io.Reader
+		ReadCb func(int, error)
+		// progress: (bytes read so far, total) where total is Size
+		// or ContentLengthUnknown - until the reader hits EOF
+		ProgressCb func(read, total int64)
+		DeferCb    func()
+		Size       int64
+		MaxBytes   int64 // when positive: fail reading (and call DeferCb) once exceeded
 	}
 	ReaderWithArgs struct {
-		args ReaderArgs
+		args     ReaderArgs
+		read     int64
+		deferred bool
+	}
+	// byte range [offset, offset+size) of a seekable reader
+	rangeRCS struct {
+		io.Reader
+		rcs  ReadCloseSizeSeeker
+		size int64
 	}
 	nopOpener struct{ io.ReadCloser }
 )
@@ -137,6 +156,14 @@ type (
 
 	WriterMulti struct{ writers []io.Writer }
 
+	// CallbackWriter counts bytes as they're written (see BytesWritten)
+	// and, optionally, reports them via user-provided callback
+	CallbackWriter struct {
+		w       io.Writer
+		writeCb func(int)
+		n       int64
+	}
+
 	// WriterOnly is a helper struct to hide `io.ReaderFrom` interface implementation
 	// As far as http.ResponseWriter (and its underlying tcp conn.), the following are tradeoffs:
 	// [-] sendfile (when sending), or
@@ -147,14 +174,16 @@ type (
 
 // interface guard
 var (
-	_ io.Reader      = (*nopReader)(nil)
-	_ ReadOpenCloser = (*FileHandle)(nil)
-	_ ReadOpenCloser = (*CallbackROC)(nil)
-	_ ReadSizer      = (*sizedReader)(nil)
-	_ ReadOpenCloser = (*SectionHandle)(nil)
-	_ ReadOpenCloser = (*FileSectionHandle)(nil)
-	_ ReadOpenCloser = (*nopOpener)(nil)
-	_ ReadOpenCloser = (*ByteHandle)(nil)
+	_ io.Reader           = (*nopReader)(nil)
+	_ ReadOpenCloser      = (*FileHandle)(nil)
+	_ ReadOpenCloser      = (*CallbackROC)(nil)
+	_ ReadSizer           = (*sizedReader)(nil)
+	_ ReadOpenCloser      = (*SectionHandle)(nil)
+	_ ReadOpenCloser      = (*FileSectionHandle)(nil)
+	_ ReadOpenCloser      = (*nopOpener)(nil)
+	_ ReadCloseSizeSeeker = (*ReaderWithArgs)(nil)
+	_ io.Writer           = (*CallbackWriter)(nil)
+	_ ReadOpenCloser      = (*ByteHandle)(nil)
 )
 
 // including "unexpecting EOF" to accommodate unsized streaming and
@@ -291,22 +320,81 @@ func (r *ReaderWithArgs) Size() int64 { return r.args.Size }
 
 func (r *ReaderWithArgs) Read(p []byte) (n int, err error) {
 	n, err = r.args.R.Read(p)
+	r.read += int64(n)
+	if r.args.MaxBytes > 0 && r.read > r.args.MaxBytes {
+		n -= int(r.read - r.args.MaxBytes)
+		r.read = r.args.MaxBytes
+		err = fmt.Errorf("%w (max %d)", ErrMaxBytes, r.args.MaxBytes)
+		r.deferCb()
+	}
 	if r.args.ReadCb != nil {
 		r.args.ReadCb(n, err)
 	}
+	if r.args.ProgressCb != nil {
+		total := r.args.Size
+		if total < 0 && err == io.EOF {
+			total = r.read
+		}
+		r.args.ProgressCb(r.read, total)
+	}
 	return n, err
 }
 
 func (*ReaderWithArgs) Open() (ReadOpenCloser, error) { panic("not supported") }
 
+// Seek requires the underlying reader to be io.Seeker; see also NewRangeReader
+func (r *ReaderWithArgs) Seek(offset int64, whence int) (int64, error) {
+	if sk, ok := r.args.R.(io.Seeker); ok {
+		return sk.Seek(offset, whence)
+	}
+	return 0, fmt.Errorf("%T: underlying reader (%T) is not seekable", r, r.args.R)
+}
+
+func (r *ReaderWithArgs) Seekable() bool {
+	_, ok := r.args.R.(io.Seeker)
+	return ok
+}
+
+//////////////
+// rangeRCS //
+//////////////
+
+// NewRangeReader seeks to `offset` and returns a reader of (at most) `size` bytes;
+// reading and closing go through the original reader, so that its byte-counting
+// and deferred callbacks (if any) are preserved
+func NewRangeReader(r ReadCloseSizeSeeker, offset, size int64) (ReadCloseSizer, error) {
+	if total := r.Size(); total >= 0 {
+		if offset < 0 || offset > total {
+			return nil, fmt.Errorf("invalid range offset %d (size %d)", offset, total)
+		}
+		size = min(size, total-offset)
+	}
+	if size < 0 {
+		return nil, fmt.Errorf("invalid range size %d", size)
+	}
+	if _, err := r.Seek(offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return &rangeRCS{io.LimitReader(r, size), r, size}, nil
+}
+
+func (r *rangeRCS) Size() int64  { return r.size }
+func (r *rangeRCS) Close() error { return r.rcs.Close() }
+
 func (r *ReaderWithArgs) Close() (err error) {
 	if rc, ok := r.args.R.(io.ReadCloser); ok {
 		err = rc.Close()
 	}
-	if r.args.DeferCb != nil {
+	r.deferCb()
+	return err
+}
+
+// (at most once)
+func (r *ReaderWithArgs) deferCb() {
+	if r.args.DeferCb != nil && !r.deferred {
+		r.deferred = true
 		r.args.DeferCb()
 	}
-	return err
 }
 
 ///////////////////
@@ -405,6 +493,27 @@ func (mw *WriterMulti) Write(b []byte) (n int, err error) {
 	return
 }
 
+////////////////////
+// CallbackWriter //
+////////////////////
+
+// writeCb is optional (nil: count only)
+func NewCallbackWriter(w io.Writer, writeCb func(int)) *CallbackWriter {
+	return &CallbackWriter{w: w, writeCb: writeCb}
+}
+
+func (cw *CallbackWriter) Write(b []byte) (n int, err error) {
+	n, err = cw.w.Write(b)
+	cw.n += int64(n)
+	if cw.writeCb != nil {
+		cw.writeCb(n)
+	}
+	return
+}
+
+// not thread-safe (same as Write)
+func (cw *CallbackWriter) BytesWritten() int64 { return cw.n }
+
 ///////////////////////
 // misc file and dir //
 ///////////////////////
@@ -0,0 +1,118 @@
+// Package cos provides common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestReaderWithArgsRange(t *testing.T) {
+	const data = "0123456789abcdefghij"
+	var (
+		read   int
+		closed bool
+		rdr    = cos.NewReaderWithArgs(cos.ReaderArgs{
+			R:       bytes.NewReader([]byte(data)),
+			Size:    int64(len(data)),
+			ReadCb:  func(n int, _ error) { read += n },
+			DeferCb: func() { closed = true },
+		})
+	)
+	tassert.Fatalf(t, rdr.Seekable(), "expected seekable reader")
+
+	rr, err := cos.NewRangeReader(rdr, 5, 10)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, rr.Size() == 10, "expected range size 10, got %d", rr.Size())
+
+	b, err := io.ReadAll(rr)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(b) == data[5:15], "expected %q, got %q", data[5:15], string(b))
+	tassert.Errorf(t, read == 10, "expected 10 bytes reported via callback, got %d", read)
+
+	tassert.CheckFatal(t, rr.Close())
+	tassert.Errorf(t, closed, "expected deferred callback to be called")
+
+	// range beyond the end gets truncated
+	rr, err = cos.NewRangeReader(rdr, 15, 100)
+	tassert.CheckFatal(t, err)
+	b, err = io.ReadAll(rr)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(b) == data[15:], "expected %q, got %q", data[15:], string(b))
+
+	_, err = cos.NewRangeReader(rdr, 21, 1)
+	tassert.Errorf(t, err != nil, "expected invalid range error")
+
+	// non-seekable
+	rdr = cos.NewReaderWithArgs(cos.ReaderArgs{R: io.MultiReader(strings.NewReader(data)), Size: int64(len(data))})
+	tassert.Errorf(t, !rdr.Seekable(), "expected non-seekable reader")
+	_, err = cos.NewRangeReader(rdr, 1, 1)
+	tassert.Errorf(t, err != nil, "expected seek error")
+}
+
+func TestCallbackWriter(t *testing.T) {
+	var (
+		buf     bytes.Buffer
+		written int
+		cw      = cos.NewCallbackWriter(&buf, func(n int) { written += n })
+	)
+	for range 3 {
+		_, err := cw.Write([]byte("hello"))
+		tassert.CheckFatal(t, err)
+	}
+	tassert.Errorf(t, written == 15, "expected 15 bytes reported, got %d", written)
+	tassert.Errorf(t, cw.BytesWritten() == 15, "expected 15 bytes written, got %d", cw.BytesWritten())
+	tassert.Errorf(t, buf.String() == "hellohellohello", "unexpected content %q", buf.String())
+}
+
+func TestCallbackWriterNoCallback(t *testing.T) {
+	cw := cos.NewCallbackWriter(io.Discard, nil)
+	n, err := io.Copy(cw, strings.NewReader(strings.Repeat("x", 1000)))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, n == 1000 && cw.BytesWritten() == 1000, "expected 1000 bytes written, got %d (%d)", cw.BytesWritten(), n)
+}
+
+func TestReaderWithArgsMaxBytes(t *testing.T) {
+	var (
+		read     int
+		deferred int
+		rdr      = cos.NewReaderWithArgs(cos.ReaderArgs{
+			R:        strings.NewReader(strings.Repeat("x", 100)),
+			Size:     cos.ContentLengthUnknown,
+			MaxBytes: 64,
+			ReadCb:   func(n int, _ error) { read += n },
+			DeferCb:  func() { deferred++ },
+		})
+	)
+	b, err := io.ReadAll(rdr)
+	tassert.Fatalf(t, errors.Is(err, cos.ErrMaxBytes), "expected ErrMaxBytes, got %v", err)
+	tassert.Errorf(t, len(b) == 64 && read == 64, "expected 64 bytes, got (%d, %d)", len(b), read)
+	tassert.Errorf(t, deferred == 1, "expected deferred callback upon exceeding max bytes")
+
+	tassert.CheckFatal(t, rdr.Close())
+	tassert.Errorf(t, deferred == 1, "deferred callback must be called only once, got %d", deferred)
+}
+
+func TestReaderWithArgsProgress(t *testing.T) {
+	for _, size := range []int64{100, cos.ContentLengthUnknown} {
+		var (
+			read, total int64
+			rdr         = cos.NewReaderWithArgs(cos.ReaderArgs{
+				R:          strings.NewReader(strings.Repeat("x", 100)),
+				Size:       size,
+				ProgressCb: func(n, t int64) { read, total = n, t },
+			})
+		)
+		_, err := io.ReadAll(rdr)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, read == 100 && total == 100, "size %d: expected (100, 100), got (%d, %d)", size, read, total)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -156,3 +157,41 @@ func _convert(s, units string, mult, multIEC int64) (val int64, err error) {
 	}
 	return val * mult, err
 }
+
+///////////////
+// SizeEWMA //
+///////////////
+
+// SizeEWMA tracks exponentially weighted moving average of observed (object) sizes;
+// to be used when the actual size is unknown (e.g., to size buffers)
+// - smoothing factor: 1/2^ewmaShift
+// - concurrency-safe; zero value is ready for use
+type SizeEWMA struct {
+	avg atomic.Int64
+}
+
+const ewmaShift = 3
+
+func (e *SizeEWMA) Add(size int64) {
+	if size <= 0 {
+		return
+	}
+	for {
+		prev := e.avg.Load()
+		next := size
+		if prev > 0 {
+			next = prev + (size-prev)>>ewmaShift
+		}
+		if e.avg.CAS(prev, next) {
+			return
+		}
+	}
+}
+
+// returns the smoothed estimate or, if nothing's been recorded yet, the provided default
+func (e *SizeEWMA) Get(dflt int64) int64 {
+	if avg := e.avg.Load(); avg > 0 {
+		return avg
+	}
+	return dflt
+}
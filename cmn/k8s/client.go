@@ -31,10 +31,17 @@ type (
 		Service(name string) (*corev1.Service, error)
 		Node(name string) (*corev1.Node, error)
 		Logs(podName string) ([]byte, error)
+		StreamPodLogs(ctx context.Context, podName string, opts *PodLogsOpts, w io.Writer) error
 		Health(podName string) (string, error)
 		CheckMetricsAvailability() error
 	}
 
+	// PodLogsOpts selects what to stream (all existing lines, by default)
+	PodLogsOpts struct {
+		Tail   int64 // number of trailing lines; zero: all
+		Follow bool  // keep streaming until ctx is done or the pod terminates
+	}
+
 	// defaultClient implements Client interface.
 	defaultClient struct {
 		client    kubernetes.Interface
@@ -194,6 +201,27 @@ func (c *defaultClient) Logs(podName string) ([]byte, error) {
 	return io.ReadAll(logStream)
 }
 
+func (c *defaultClient) StreamPodLogs(ctx context.Context, podName string, opts *PodLogsOpts, w io.Writer) error {
+	logOpts := &corev1.PodLogOptions{}
+	if opts != nil {
+		logOpts.Follow = opts.Follow
+		if opts.Tail > 0 {
+			tail := opts.Tail
+			logOpts.TailLines = &tail
+		}
+	}
+	logStream, err := c.pods().GetLogs(podName, logOpts).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, logStream)
+	logStream.Close()
+	if err != nil && ctx.Err() != nil {
+		err = nil // canceled by the caller (e.g., client disconnected while following)
+	}
+	return err
+}
+
 func (c *defaultClient) CheckMetricsAvailability() error {
 	_, err := c.client.CoreV1().RESTClient().Get().AbsPath("/apis/metrics.k8s.io/v1beta1/pods").DoRaw(context.Background())
 	return err
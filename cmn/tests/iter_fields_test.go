@@ -69,6 +69,13 @@ var _ = Describe("IterFields", func() {
 					"mirror.enabled":      false,
 					"mirror.copies":       int64(0),
 					"mirror.burst_buffer": 0,
+					"mirror.placement":    "",
+					"mirror.label":        "",
+					"mirror.lruwm":        int64(0),
+					"mirror.inodeswm":     int64(0),
+					"mirror.affinity":     "",
+					"mirror.batch_size":   0,
+					"mirror.batch_pause":  cos.Duration(0),
 
 					"ec.enabled":           true,
 					"ec.parity_slices":     1024,
@@ -128,6 +135,13 @@ var _ = Describe("IterFields", func() {
 					"mirror.enabled":      (*bool)(nil),
 					"mirror.copies":       (*int64)(nil),
 					"mirror.burst_buffer": (*int)(nil),
+					"mirror.placement":    (*string)(nil),
+					"mirror.label":        (*string)(nil),
+					"mirror.lruwm":        (*int64)(nil),
+					"mirror.inodeswm":     (*int64)(nil),
+					"mirror.affinity":     (*string)(nil),
+					"mirror.batch_size":   (*int)(nil),
+					"mirror.batch_pause":  (*cos.Duration)(nil),
 
 					"ec.enabled":           apc.Ptr(true),
 					"ec.parity_slices":     apc.Ptr(1024),
@@ -0,0 +1,67 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// LoadLOMs initializes (once) the bucket and then initializes and loads the named objects;
+// with `parallel` > 1, up to so many goroutines will be loading concurrently.
+// Returns LOMs and errors indexed as per `objNames`, with exactly one of the two being non-nil.
+// The caller is responsible for freeing returned LOMs (see FreeLOM).
+func LoadLOMs(bck *meta.Bck, objNames []string, parallel int) (loms []*LOM, errs []error) {
+	loms, errs = make([]*LOM, len(objNames)), make([]error, len(objNames))
+	if err := bck.InitFast(T.Bowner()); err != nil {
+		for i := range errs {
+			errs[i] = err
+		}
+		return loms, errs
+	}
+	if parallel <= 1 || len(objNames) <= 1 {
+		for i, objName := range objNames {
+			loms[i], errs[i] = loadLOM(bck, objName)
+		}
+		return loms, errs
+	}
+
+	var (
+		wg     sync.WaitGroup
+		workCh = make(chan int, len(objNames))
+	)
+	for i := range objNames {
+		workCh <- i
+	}
+	close(workCh)
+	for range min(parallel, len(objNames)) {
+		wg.Add(1)
+		go func() {
+			for i := range workCh {
+				loms[i], errs[i] = loadLOM(bck, objNames[i])
+			}
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+	return loms, errs
+}
+
+func loadLOM(bck *meta.Bck, objName string) (*LOM, error) {
+	debug.Assert(bck.Props != nil, bck.String()+" must be initialized")
+	lom := AllocLOM(objName)
+	lom.bck = *bck
+	if err := lom.initHrw(); err != nil {
+		FreeLOM(lom)
+		return nil, err
+	}
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		FreeLOM(lom)
+		return nil, err
+	}
+	return lom, nil
+}
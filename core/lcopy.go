@@ -8,10 +8,13 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/ios"
 )
 
 //
@@ -137,6 +140,43 @@ func (lom *LOM) DelExtraCopies(fqn ...string) (removed bool, err error) {
 	return
 }
 
+// returns recorded copies (FQNs) that are missing - either don't exist or reside on
+// mountpaths that are not currently available (e.g., failed or disabled)
+func (lom *LOM) MissingCopies() (fqns []string) {
+	if !lom.HasCopies() {
+		return nil
+	}
+	availablePaths := fs.GetAvail()
+	for copyFQN, mi := range lom.md.copies {
+		if copyFQN == lom.FQN {
+			continue
+		}
+		if _, ok := availablePaths[mi.Path]; !ok {
+			fqns = append(fqns, copyFQN)
+		} else if err := cos.Stat(copyFQN); err != nil && os.IsNotExist(err) {
+			fqns = append(fqns, copyFQN)
+		}
+	}
+	return
+}
+
+// removes missing copies (see above) from metadata and persists the latter;
+// returns the number of removed copies
+// NOTE: must be called under w-lock
+func (lom *LOM) DelMissingCopies() (n int, err error) {
+	missing := lom.MissingCopies()
+	if len(missing) == 0 {
+		return 0, nil
+	}
+	for _, copyFQN := range missing {
+		lom.delCopyMd(copyFQN)
+	}
+	if err = lom.syncMetaWithCopies(); err != nil {
+		return
+	}
+	return len(missing), lom.Persist()
+}
+
 // syncMetaWithCopies tries to make sure that all copies have identical metadata.
 // NOTE: uname for LOM must be already locked.
 // NOTE: changes _may_ be made - the caller must call lom.Persist() upon return
@@ -221,24 +261,38 @@ func (lom *LOM) _restore(fqn string, buf []byte) (dst *LOM, err error) {
 
 // increment the object's num copies by (well) copying the former
 // (compare with lom.Copy2FQN below)
-func (lom *LOM) Copy(mi *fs.Mountpath, buf []byte) (err error) {
-	var (
-		copyFQN = mi.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName)
-		workFQN = mi.MakePathFQN(lom.Bucket(), fs.WorkfileType, fs.WorkfileCopy+"."+lom.ObjName)
-	)
+func (lom *LOM) Copy(mi *fs.Mountpath, buf []byte) error {
+	copyFQN, err := lom.CopyData(mi, buf)
+	if err != nil {
+		return err
+	}
+	return lom.AddCopyPersist(copyFQN, mi)
+}
+
+// the data part of lom.Copy() - creates a replica on the given mountpath
+// (or skips copying when there's one already and it is identical);
+//   - does not modify the object's metadata and can, therefore, run concurrently
+//     for distinct mountpaths (with the source w-locked by the caller);
+//   - to complete, call AddCopyPersist() - one at a time
+func (lom *LOM) CopyData(mi *fs.Mountpath, buf []byte) (copyFQN string, err error) {
+	copyFQN = mi.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName)
+	workFQN := mi.MakePathFQN(lom.Bucket(), fs.WorkfileType, fs.WorkfileCopy+"."+lom.ObjName)
+
 	// check if the copy destination exists and then skip copying if it's also identical
 	if errExists := cos.Stat(copyFQN); errExists == nil {
 		cplom := AllocLOM(lom.ObjName)
 		defer FreeLOM(cplom)
 		if errExists = cplom.InitFQN(copyFQN, lom.Bucket()); errExists == nil {
 			if errExists = cplom.Load(false /*cache it*/, true /*locked*/); errExists == nil && cplom.Equal(lom) {
-				goto add
+				return
 			}
 		}
 	}
 
 	// copy
+	mi.IncInflight()
 	_, _, err = cos.CopyFile(lom.FQN, workFQN, buf, cos.ChecksumNone) // TODO: checksumming
+	mi.DecInflight()
 	if err != nil {
 		return
 	}
@@ -248,12 +302,28 @@ func (lom *LOM) Copy(mi *fs.Mountpath, buf []byte) (err error) {
 		}
 		return
 	}
-add:
-	// add md and persist
+	// optionally, re-read the new replica to make sure it's intact (see checksum.validate_obj_move)
+	if lom.CksumConf().ValidateObjMove {
+		if err = lom.validateCopy(copyFQN); err != nil {
+			if errRemove := cos.RemoveFile(copyFQN); errRemove != nil && !os.IsNotExist(errRemove) {
+				nlog.Errorln("nested err:", errRemove)
+			}
+		}
+	}
+	return
+}
+
+// the metadata part of lom.Copy() - adds the replica created by CopyData() and persists;
+// upon failure, rolls back both the metadata and the replica itself (not to leave orphans)
+// NOTE: must be called under w-lock
+func (lom *LOM) AddCopyPersist(copyFQN string, mi *fs.Mountpath) (err error) {
 	lom.AddCopy(copyFQN, mi)
 	err = lom.Persist()
 	if err != nil {
 		lom.delCopyMd(copyFQN)
+		if errRemove := cos.RemoveFile(copyFQN); errRemove != nil && !os.IsNotExist(errRemove) {
+			nlog.Errorln("nested err:", errRemove)
+		}
 		nlog.Errorln(err)
 		return err
 	}
@@ -261,6 +331,38 @@ add:
 	return
 }
 
+// recompute the checksum of the (just created) copy and compare it with the source;
+// the type of checksum is determined by the bucket's checksum.type - when the object's
+// stored checksum is of a different type (or missing), the source gets checksummed as well
+// (no-op when the bucket is not checksummed and neither is the object)
+func (lom *LOM) validateCopy(copyFQN string) error {
+	var (
+		cksum = lom.Checksum()
+		ty    = lom.CksumConf().Type
+	)
+	if ty == "" || ty == cos.ChecksumNone {
+		if cksum.IsEmpty() {
+			return nil
+		}
+		ty = cksum.Ty()
+	}
+	if cksum.IsEmpty() || cksum.Ty() != ty {
+		srcHash, err := computeCksum(lom.FQN, ty)
+		if err != nil {
+			return err
+		}
+		cksum = &srcHash.Cksum
+	}
+	cksumHash, err := computeCksum(copyFQN, ty)
+	if err != nil {
+		return err
+	}
+	if !cksumHash.Equal(cksum) {
+		return cos.NewErrDataCksum(&cksumHash.Cksum, cksum, copyFQN)
+	}
+	return nil
+}
+
 // copy object => any local destination
 // recommended for copying between different buckets (compare with lom.Copy() above)
 // NOTE: `lom` source must be w-locked
@@ -276,6 +378,64 @@ func (lom *LOM) Copy2FQN(dstFQN string, buf []byte) (dst *LOM, err error) {
 	return
 }
 
+// RenameObject moves `lom` to a new bucket and/or name within this target:
+//   - source and destination share a mountpath: rename the file and persist the new
+//     metadata without copying any bytes;
+//   - otherwise (or when the source is mirrored): copy, and then remove the source.
+//
+// NOTE: the caller must w-lock both the source and the destination
+func (lom *LOM) RenameObject(toBck *meta.Bck, toName string, buf []byte) (dst *LOM, err error) {
+	existing := AllocLOM(toName)
+	defer FreeLOM(existing)
+	if err = existing.InitBck(toBck.Bucket()); err != nil {
+		return nil, err
+	}
+	dstFQN := existing.FQN
+	if dstFQN == lom.FQN {
+		return nil, fmt.Errorf("%s: cannot rename object onto itself", lom)
+	}
+
+	// slow path
+	if existing.mi.Path != lom.mi.Path || lom.HasCopies() {
+		if dst, err = lom.Copy2FQN(dstFQN, buf); err != nil {
+			return nil, err
+		}
+		if errRemove := lom.Remove(); errRemove != nil {
+			nlog.Errorln("failed to remove", lom.Cname(), "upon copying:", errRemove)
+		}
+		return dst, nil
+	}
+
+	// fast path: same mountpath
+	// - destination that already exists may have replicas - remove them first
+	if existing.Load(false /*cache it*/, true /*locked*/) == nil && existing.HasCopies() {
+		if err = existing.DelAllCopies(); err != nil {
+			return nil, err
+		}
+	}
+	dst = lom.CloneMD(dstFQN)
+	if err = dst.InitFQN(dstFQN, nil); err != nil {
+		FreeLOM(dst)
+		return nil, err
+	}
+	if !dst.Bck().Equal(lom.Bck(), true /*same ID*/, true /*same backend*/) {
+		dst.SetVersion(lomInitialVersion)
+	}
+	if err = cos.Rename(lom.FQN, dstFQN); err != nil {
+		FreeLOM(dst)
+		return nil, err
+	}
+	if err = dst.Persist(); err != nil {
+		if errRename := os.Rename(dstFQN, lom.FQN); errRename != nil {
+			nlog.Errorln("nested err:", errRename)
+		}
+		FreeLOM(dst)
+		return nil, err
+	}
+	lom.invalidate()
+	return dst, nil
+}
+
 func (lom *LOM) copy2fqn(dst *LOM, buf []byte) (err error) {
 	var (
 		dstCksum  *cos.CksumHash
@@ -374,24 +534,244 @@ func (lom *LOM) leastUtilCopy() (fqn string) {
 }
 
 // returns the least utilized mountpath that does _not_ have a copy of this `lom` yet
-// (compare with leastUtilCopy())
-func (lom *LOM) LeastUtilNoCopy() (mi *fs.Mountpath) {
+// (see mpathSel below)
+func (lom *LOM) LeastUtilNoCopy() *fs.Mountpath {
+	sel := lom.newMpathSel()
+	return sel.pick(nil)
+}
+
+// returns up to `n` distinct mountpaths that do _not_ have a copy of this `lom` yet,
+// in the order of selection (the first one being the same as LeastUtilNoCopy returns)
+func (lom *LOM) LeastUtilNoCopyN(n int) []*fs.Mountpath {
+	sel := lom.newMpathSel()
+	return sel.pickN(n)
+}
+
+// dry-run version of the above: copies planned so far (that is, not written) are deducted
+// from the mountpaths' free capacity and counted as in-flight; the selected mountpaths get
+// added to the plan
+func (lom *LOM) LeastUtilNoCopyPlan(n int, plan CopyPlan) (mis []*fs.Mountpath) {
+	sel := lom.newMpathSel()
+	sel.plan = plan
+	mis = sel.pickN(n)
+	for _, mi := range mis {
+		p, ok := plan[mi.Path]
+		if !ok {
+			p = &MpathPlan{}
+			plan[mi.Path] = p
+		}
+		p.Cnt++
+		p.Size += lom.SizeBytes()
+	}
+	return
+}
+
+// utilization penalty (in percentage points) per in-flight copy - see mpathSel.score
+const inflightUtil = 10
+
+type (
+	// copies planned per mountpath (see LeastUtilNoCopyPlan)
+	CopyPlan  map[string]*MpathPlan
+	MpathPlan struct {
+		Cnt  int64 // number of copies
+		Size int64 // total size
+	}
+
+	// mpathSel selects a destination for a new copy of the object. Available mountpaths and
+	// their utilizations are snapshotted once (per selection of one or more mountpaths).
+	//
+	// Mountpaths that cannot take the copy are excluded: those that already store (or were
+	// already selected to store) a replica, those being disabled or detached (fs.FlagWaitingDD),
+	// those at or above space.highwm, and those low on free inodes (mirror.inodeswm) - free
+	// bytes won't help when there are no inodes left to store (many small) objects.
+	//
+	// The remaining candidates are ranked in a single pass, by the following criteria
+	// in the order of precedence:
+	//   1. not sharing physical disk(s) with the existing replicas - two replicas on the same
+	//      device do not add redundancy;
+	//   2. matching mirror.label, if specified (storage class);
+	//   3. mirror.affinity, if specified: same ("near") or different ("far") label as the
+	//      mountpath of the main replica - labels are assigned by the user, and it is up to
+	//      the user to have them reflect the storage topology (controller, NUMA node, etc.);
+	//   4. used capacity below mirror.lruwm (or space.lowwm) - not to place new copies where
+	//      LRU would soon evict them;
+	//   5. disk utilization (including copies in flight), with ties broken in favor of the
+	//      mountpath with more free space; or else, with mirror.placement = "capacity",
+	//      used capacity (with ties broken by utilization) - to balance fill ratio across
+	//      disks of different sizes.
+	//
+	// (compare with leastUtilCopy())
+	mpathSel struct {
+		lom        *LOM
+		plan       CopyPlan // (dry-run only)
+		avail      fs.MPI
+		utils      *ios.MpathUtil
+		label      ios.Label
+		affinity   string
+		highWM     int32
+		lruWM      int32
+		minInodes  int32
+		byCapacity bool
+	}
+	// (lower is better)
+	mpathScore struct {
+		tiers [4]bool // see mpathSel criteria 1 through 4
+		pct   int32
+		util  int64
+		avail uint64
+	}
+)
+
+func (lom *LOM) newMpathSel() *mpathSel {
 	var (
-		availablePaths = fs.GetAvail()
-		mpathUtils     = fs.GetAllMpathUtils()
-		minUtil        = int64(101) // to motivate the first assignment
+		config = cmn.GCO.Get()
+		mirror = lom.MirrorConf()
+		sel    = &mpathSel{
+			lom:        lom,
+			avail:      fs.GetAvail(),
+			utils:      fs.GetAllMpathUtils(),
+			label:      ios.Label(mirror.Label),
+			affinity:   mirror.Affinity,
+			highWM:     int32(config.Space.HighWM),
+			lruWM:      int32(mirror.LruWM),
+			minInodes:  mirror.MinFreeInodes(),
+			byCapacity: mirror.CapacityPlacement(),
+		}
 	)
-	for mpath, mpathInfo := range availablePaths {
-		if lom.haveMpath(mpath) || mpathInfo.IsAnySet(fs.FlagWaitingDD) {
+	if sel.lruWM == 0 {
+		sel.lruWM = int32(config.Space.LowWM)
+	}
+	if sel.highWM > 0 && sel.lruWM >= sel.highWM {
+		sel.lruWM = 0 // (nothing to deprioritize)
+	}
+	return sel
+}
+
+func (sel *mpathSel) pickN(n int) (mis []*fs.Mountpath) {
+	for len(mis) < n {
+		mi := sel.pick(mis)
+		if mi == nil {
+			break
+		}
+		mis = append(mis, mi)
+	}
+	return
+}
+
+// the best candidate other than those already selected (`skip`), or nil if none
+func (sel *mpathSel) pick(skip []*fs.Mountpath) (mi *fs.Mountpath) {
+	var (
+		best  mpathScore
+		lom   = sel.lom
+		disks = lom.copyDisks(skip)
+	)
+	for mpath, candidate := range sel.avail {
+		if lom.haveMpath(mpath) || candidate.IsAnySet(fs.FlagWaitingDD) || _inMpaths(skip, candidate) {
+			continue
+		}
+		if pct := candidate.PctFreeInodes(); pct < sel.minInodes {
+			if cmn.Rom.FastV(4, cos.SmoduleMirror) {
+				nlog.Infof("%s: skipping %s - low on inodes (%d%% free, min %d%%)", lom.Cname(), candidate, pct, sel.minInodes)
+			}
 			continue
 		}
-		if util := mpathUtils.Get(mpath); util < minUtil {
-			minUtil, mi = util, mpathInfo
+		c := candidate.CapCached()
+		if sel.plan != nil {
+			sel.plan.deduct(mpath, &c)
+		}
+		if sel.highWM > 0 && c.PctUsed >= sel.highWM {
+			continue
+		}
+		score := sel.score(candidate, &c, disks)
+		if mi == nil || score.less(&best, sel.byCapacity) {
+			mi, best = candidate, score
+		}
+	}
+	return
+}
+
+func (sel *mpathSel) score(mi *fs.Mountpath, c *fs.Capacity, disks cos.StrSet) (score mpathScore) {
+	score.tiers[0] = _sharesDisk(disks, mi)
+	score.tiers[1] = sel.label != "" && mi.Label != sel.label
+	switch sel.affinity {
+	case cmn.AffinityNear:
+		score.tiers[2] = mi.Label != sel.lom.mi.Label
+	case cmn.AffinityFar:
+		score.tiers[2] = mi.Label == sel.lom.mi.Label
+	}
+	score.tiers[3] = sel.lruWM > 0 && c.PctUsed >= sel.lruWM
+
+	// account for the copies that are already being written (and are yet to show up in the utilization)
+	inflight := mi.Inflight()
+	if p, ok := sel.plan[mi.Path]; ok {
+		inflight += p.Cnt
+	}
+	score.util = sel.utils.Get(mi.Path) + inflightUtil*inflight
+	score.pct, score.avail = c.PctUsed, c.Avail
+	return
+}
+
+func (a *mpathScore) less(b *mpathScore, byCapacity bool) bool {
+	for i := range a.tiers {
+		if a.tiers[i] != b.tiers[i] {
+			return !a.tiers[i]
 		}
 	}
+	if byCapacity {
+		return a.pct < b.pct || (a.pct == b.pct && a.util < b.util)
+	}
+	return a.util < b.util || (a.util == b.util && a.avail > b.avail)
+}
+
+// capacity that'd remain if the planned copies were written
+func (plan CopyPlan) deduct(mpath string, c *fs.Capacity) {
+	p, ok := plan[mpath]
+	if !ok || p.Size <= 0 {
+		return
+	}
+	size := min(uint64(p.Size), c.Avail)
+	c.Used += size
+	c.Avail -= size
+	if total := c.Used + c.Avail; total > 0 {
+		c.PctUsed = int32(c.Used * 100 / total)
+	}
+}
+
+// disks that already store (or are selected to store) this object's replicas
+func (lom *LOM) copyDisks(skip []*fs.Mountpath) (disks cos.StrSet) {
+	disks = cos.NewStrSet()
+	if len(lom.md.copies) == 0 {
+		disks.Add(lom.mi.Disks...)
+	}
+	for _, mi := range lom.md.copies {
+		if mi != nil {
+			disks.Add(mi.Disks...)
+		}
+	}
+	for _, mi := range skip {
+		disks.Add(mi.Disks...)
+	}
 	return
 }
 
+func _sharesDisk(disks cos.StrSet, mi *fs.Mountpath) bool {
+	for _, disk := range mi.Disks {
+		if disks.Contains(disk) {
+			return true
+		}
+	}
+	return false
+}
+
+func _inMpaths(mis []*fs.Mountpath, mi *fs.Mountpath) bool {
+	for _, m := range mis {
+		if m == mi {
+			return true
+		}
+	}
+	return false
+}
+
 func (lom *LOM) haveMpath(mpath string) bool {
 	if len(lom.md.copies) == 0 {
 		return lom.mi.Path == mpath
@@ -51,7 +51,7 @@ func (lom *LOM) Remove(force ...bool) (err error) {
 		rc, exclusive := lom.IsLocked()
 		return exclusive || (len(force) > 0 && force[0] && rc > 0)
 	})
-	lom.Uncache()
+	lom.invalidate()
 	err = cos.RemoveFile(lom.FQN)
 	if os.IsNotExist(err) {
 		err = nil
@@ -61,7 +61,6 @@ func (lom *LOM) Remove(force ...bool) (err error) {
 			err = erc
 		}
 	}
-	lom.md.bckID = 0
 	return err
 }
 
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs"
@@ -83,6 +84,11 @@ func (lom *LOM) InitBck(bck *cmn.Bck) (err error) {
 	if err = lom.bck.InitFast(T.Bowner()); err != nil {
 		return
 	}
+	return lom.initHrw()
+}
+
+// (bucket must be already initialized)
+func (lom *LOM) initHrw() (err error) {
 	lom.md.uname = lom.bck.MakeUname(lom.ObjName)
 	lom.mi, lom.digest, err = fs.Hrw(lom.md.uname)
 	if err != nil {
@@ -113,6 +119,12 @@ func (lom *LOM) CloneMD(fqn string) *LOM {
 	dst.md = lom.md
 	dst.md.bckID = 0
 	dst.md.copies = nil
+	if len(lom.md.CustomMD) > 0 { // (not to share the map with the source)
+		dst.md.CustomMD = make(cos.StrKVs, len(lom.md.CustomMD))
+		for k, v := range lom.md.CustomMD {
+			dst.md.CustomMD[k] = v
+		}
+	}
 	dst.FQN = fqn
 	return dst
 }
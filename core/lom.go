@@ -373,12 +373,17 @@ func (lom *LOM) ComputeSetCksum() (*cos.Cksum, error) {
 	return cksum, nil
 }
 
-func (lom *LOM) ComputeCksum(cksumType string) (cksum *cos.CksumHash, err error) {
+func (lom *LOM) ComputeCksum(cksumType string) (*cos.CksumHash, error) {
+	return computeCksum(lom.FQN, cksumType)
+}
+
+// (compare w/ lom.ComputeCksum above)
+func computeCksum(fqn, cksumType string) (cksum *cos.CksumHash, err error) {
 	var file *os.File
 	if cksumType == cos.ChecksumNone {
 		return
 	}
-	if file, err = os.Open(lom.FQN); err != nil {
+	if file, err = os.Open(fqn); err != nil {
 		return
 	}
 	// No need to allocate `buf` as `io.Discard` has efficient `io.ReaderFrom` implementation.
@@ -513,6 +518,13 @@ func (lom *LOM) Uncache() {
 	}
 }
 
+// uncache and mark in-memory metadata not loaded (e.g., upon removal or rename),
+// so that subsequent use requires (re)loading
+func (lom *LOM) invalidate() {
+	lom.Uncache()
+	lom.md.bckID = 0
+}
+
 // remove from cache unless dirty
 func (lom *LOM) UncacheUnless() {
 	lcache, lmd := lom.fromCache()
@@ -19,6 +19,7 @@ import (
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/core/mock"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/ios"
 	"github.com/NVIDIA/aistore/tools/cryptorand"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -895,6 +896,195 @@ var _ = Describe("LOM", func() {
 			})
 		})
 
+		Describe("RenameObject", func() {
+			// find destination name that maps (or does not map) onto the source's mountpath
+			findName := func(src *core.LOM, samemi bool) string {
+				for i := range 1000 {
+					name := fmt.Sprintf("renamed/obj-%d", i)
+					tmp := &core.LOM{ObjName: name}
+					Expect(tmp.InitBck(&localBckA)).NotTo(HaveOccurred())
+					if (tmp.Mountpath().Path == src.Mountpath().Path) == samemi {
+						return name
+					}
+				}
+				cos.Assert(false)
+				return ""
+			}
+			checkRenamed := func(lom, dst *core.LOM, expectedHash string) {
+				Expect(lom.FQN).NotTo(BeAnExistingFile())
+				Expect(dst.FQN).To(BeARegularFile())
+				dst = NewBasicLom(dst.FQN)
+				Expect(dst.Load(false, false)).NotTo(HaveOccurred())
+				Expect(dst.SizeBytes(true)).To(BeEquivalentTo(testFileSize))
+				Expect(getTestFileHash(dst.FQN)).To(Equal(expectedHash))
+			}
+
+			It("should rename the object when on the same mountpath", func() {
+				lom := prepareLOM(copyFQNs[0])
+				expectedHash := getTestFileHash(lom.FQN)
+				bck := meta.CloneBck(&localBckA)
+				lom.Lock(true)
+				dst, err := lom.RenameObject(bck, findName(lom, true), nil)
+				lom.Unlock(true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dst.Mountpath().Path).To(Equal(lom.Mountpath().Path))
+				checkRenamed(lom, dst, expectedHash)
+			})
+
+			It("should copy and remove the source when mountpaths differ", func() {
+				lom := prepareLOM(copyFQNs[0])
+				expectedHash := getTestFileHash(lom.FQN)
+				bck := meta.CloneBck(&localBckA)
+				lom.Lock(true)
+				dst, err := lom.RenameObject(bck, findName(lom, false), make([]byte, testFileSize))
+				lom.Unlock(true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dst.Mountpath().Path).NotTo(Equal(lom.Mountpath().Path))
+				checkRenamed(lom, dst, expectedHash)
+			})
+		})
+
+		Describe("Copy with validate_obj_move", func() {
+			var mi *fs.Mountpath
+			BeforeEach(func() {
+				bck := cmn.Bck{Name: bucketLocalC, Provider: apc.AIS, Ns: cmn.NsGlobal}
+				for _, m := range mis {
+					if m.MakePathFQN(&bck, fs.ObjectType, testObjectName) == mirrorFQNs[1] {
+						mi = m
+					}
+				}
+				Expect(mi).NotTo(BeNil())
+			})
+
+			It("should add a copy that passes validation", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				lom.CksumConf().ValidateObjMove = true
+				defer func() { lom.CksumConf().ValidateObjMove = false }()
+
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				Expect(lom.Copy(mi, make([]byte, testFileSize))).NotTo(HaveOccurred())
+				Expect(lom.NumCopies()).To(Equal(2))
+				Expect(mirrorFQNs[1]).To(BeARegularFile())
+			})
+
+			It("should validate the copy with the bucket-configured checksum type", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				lom.CksumConf().ValidateObjMove = true
+				cksumType := lom.CksumConf().Type
+				lom.CksumConf().Type = cos.ChecksumMD5
+				defer func() {
+					lom.CksumConf().ValidateObjMove = false
+					lom.CksumConf().Type = cksumType
+				}()
+				Expect(lom.Checksum().Ty()).NotTo(Equal(cos.ChecksumMD5))
+
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				Expect(lom.Copy(mi, make([]byte, testFileSize))).NotTo(HaveOccurred())
+				Expect(lom.NumCopies()).To(Equal(2))
+			})
+
+			It("should remove the copy and fail upon checksum mismatch", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				lom.CksumConf().ValidateObjMove = true
+				defer func() { lom.CksumConf().ValidateObjMove = false }()
+
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				lom.SetCksum(cos.NewCksum(cos.ChecksumXXHash, "01234567"))
+				err := lom.Copy(mi, make([]byte, testFileSize))
+				Expect(cos.IsErrBadCksum(err)).To(BeTrue())
+				Expect(lom.NumCopies()).To(Equal(1))
+				Expect(cos.Stat(mirrorFQNs[1])).To(HaveOccurred())
+			})
+		})
+
+		Describe("AddCopyPersist", func() {
+			It("should remove the replica when failing to persist metadata", func() {
+				var (
+					mi  *fs.Mountpath
+					bck = cmn.Bck{Name: bucketLocalC, Provider: apc.AIS, Ns: cmn.NsGlobal}
+				)
+				for _, m := range mis {
+					if m.MakePathFQN(&bck, fs.ObjectType, testObjectName) == mirrorFQNs[1] {
+						mi = m
+					}
+				}
+				lom := prepareLOM(mirrorFQNs[0])
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				copyFQN, err := lom.CopyData(mi, make([]byte, testFileSize))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(copyFQN).To(BeARegularFile())
+
+				// inject failure: main replica's metadata can't be written
+				Expect(os.Remove(lom.FQN)).NotTo(HaveOccurred())
+				Expect(lom.AddCopyPersist(copyFQN, mi)).To(HaveOccurred())
+				Expect(lom.NumCopies()).To(Equal(1))
+				Expect(cos.Stat(copyFQN)).To(HaveOccurred()) // no orphans
+			})
+		})
+
+		Describe("custom metadata", func() {
+			custom := cos.StrKVs{cmn.ETag: "\"etag-1234\"", "user-tag": "abc"}
+			withCustom := func(fqn string) *core.LOM {
+				lom := prepareLOM(fqn)
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				lom.SetCustomMD(custom)
+				Expect(lom.Persist()).NotTo(HaveOccurred())
+				return lom
+			}
+
+			It("should carry custom metadata over to the mirrored copy", func() {
+				lom := withCustom(mirrorFQNs[0])
+				_ = prepareCopy(lom, mirrorFQNs[1])
+
+				// (the replica's own on-disk metadata)
+				copyLOM := NewBasicLom(mirrorFQNs[1])
+				Expect(copyLOM.LoadMetaFromFS()).NotTo(HaveOccurred())
+				Expect(copyLOM.GetCustomMD()).To(Equal(custom))
+			})
+
+			It("should carry custom metadata over to the copy in another bucket", func() {
+				lom := withCustom(copyFQNs[0])
+				copyLOM := prepareCopy(lom, copyFQNs[1])
+				Expect(copyLOM.GetCustomMD()).To(Equal(custom))
+
+			})
+
+			It("should not share custom metadata between the source and its clone", func() {
+				lom := withCustom(copyFQNs[0])
+				clone := lom.CloneMD(copyFQNs[1])
+				defer core.FreeLOM(clone)
+				clone.SetCustomKey("user-tag", "xyz")
+				Expect(lom.GetCustomMD()).To(Equal(custom))
+			})
+		})
+
+		Describe("MissingCopies", func() {
+			It("should detect and remove missing copies", func() {
+				lom := prepareLOM(mirrorFQNs[0])
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				_ = prepareCopy(lom, mirrorFQNs[1], true)
+				_ = prepareCopy(lom, mirrorFQNs[2], true)
+				Expect(lom.NumCopies()).To(Equal(3))
+				Expect(lom.MissingCopies()).To(BeEmpty())
+
+				Expect(os.Remove(mirrorFQNs[1])).NotTo(HaveOccurred())
+				Expect(lom.MissingCopies()).To(ConsistOf(mirrorFQNs[1]))
+
+				n, err := lom.DelMissingCopies()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(n).To(Equal(1))
+				Expect(lom.NumCopies()).To(Equal(2))
+				Expect(lom.MissingCopies()).To(BeEmpty())
+				checkCopies(lom, mirrorFQNs[0], mirrorFQNs[2])
+			})
+		})
+
 		Describe("DelCopies", func() {
 			It("should delete mirrored copy", func() {
 				lom := prepareLOM(mirrorFQNs[0])
@@ -993,6 +1183,277 @@ var _ = Describe("LOM", func() {
 				Expect(lom.GetCopies()).To(BeNil())
 			})
 		})
+
+		Describe("LeastUtilNoCopy", func() {
+			var (
+				mios   *mock.IOS
+				others []string // mountpaths other than the lom's own
+			)
+			// (re)initialize mountpaths with the given iostater
+			reinit := func(iostater ios.IOS) {
+				fs.TestNew(iostater)
+				for _, mpath := range mpaths {
+					_ = cos.CreateDir(mpath)
+					_, _ = fs.Add(mpath, "daeID")
+				}
+			}
+			BeforeEach(func() {
+				mios = mock.NewIOS()
+				reinit(mios)
+				config := cmn.GCO.BeginUpdate()
+				config.Space.HighWM = 90
+				cmn.GCO.CommitUpdate(config)
+			})
+			AfterEach(func() {
+				reinit(nil)
+				config := cmn.GCO.BeginUpdate()
+				config.Space.HighWM = 0
+				cmn.GCO.CommitUpdate(config)
+			})
+			prepare := func() *core.LOM {
+				lom := prepareLOM(copyFQNs[0])
+				others = others[:0]
+				for _, mpath := range mpaths {
+					if mpath != lom.Mountpath().Path {
+						others = append(others, mpath)
+					}
+				}
+				Expect(others).To(HaveLen(numMpaths - 1))
+				return lom
+			}
+			setCap := func(mpath string, avail uint64, pct int32) {
+				avail0, _ := fs.Get()
+				avail0[mpath].TestSetCapacity(fs.Capacity{Avail: avail, PctUsed: pct})
+			}
+
+			It("should skip the least utilized mountpath when it is nearly full", func() {
+				lom := prepare()
+				mios.Utils.Set(others[0], 10)
+				mios.Utils.Set(others[1], 50)
+				setCap(others[0], cos.MiB, 99)
+				setCap(others[1], 100*cos.GiB, 10)
+
+				mi := lom.LeastUtilNoCopy()
+				Expect(mi).NotTo(BeNil())
+				Expect(mi.Path).To(Equal(others[1]))
+			})
+
+			It("should prefer more free space when utilizations are equal", func() {
+				lom := prepare()
+				mios.Utils.Set(others[0], 20)
+				mios.Utils.Set(others[1], 20)
+				setCap(others[0], 10*cos.GiB, 50)
+				setCap(others[1], 100*cos.GiB, 10)
+
+				mi := lom.LeastUtilNoCopy()
+				Expect(mi).NotTo(BeNil())
+				Expect(mi.Path).To(Equal(others[1]))
+			})
+
+			It("should take into account planned (dry-run) copies", func() {
+				lom := prepare()
+				mios.Utils.Set(others[0], 20)
+				mios.Utils.Set(others[1], 20)
+				setCap(others[0], 10*cos.GiB, 50)
+				setCap(others[1], 100*cos.GiB, 10)
+
+				var (
+					plan = make(core.CopyPlan, 2)
+					cnt  = make(map[string]int, 2)
+				)
+				for range 10 {
+					mis := lom.LeastUtilNoCopyPlan(1, plan)
+					Expect(mis).To(HaveLen(1))
+					cnt[mis[0].Path]++
+				}
+				// planned copies count as in-flight - no single mountpath gets them all
+				Expect(cnt).To(HaveLen(2))
+				Expect(plan[others[0]].Cnt + plan[others[1]].Cnt).To(BeEquivalentTo(10))
+				Expect(plan[others[1]].Size).To(BeEquivalentTo(int64(cnt[others[1]]) * lom.SizeBytes()))
+
+				// (real selection is not affected)
+				Expect(lom.LeastUtilNoCopy().Path).To(Equal(others[1]))
+			})
+
+			It("should prefer the least filled mountpath with capacity placement", func() {
+				lom := prepare()
+				lom.MirrorConf().Placement = cmn.PlacementCapacity
+				defer func() { lom.MirrorConf().Placement = "" }()
+				mios.Utils.Set(others[0], 10)
+				mios.Utils.Set(others[1], 50)
+				setCap(others[0], 100*cos.GiB, 60) // smaller disk, more filled
+				setCap(others[1], 10*cos.GiB, 20)
+
+				mi := lom.LeastUtilNoCopy()
+				Expect(mi).NotTo(BeNil())
+				Expect(mi.Path).To(Equal(others[1]))
+			})
+
+			It("should prefer mountpaths with the bucket's mirror.label", func() {
+				lom := prepare()
+				lom.MirrorConf().Label = "ssd"
+				defer func() { lom.MirrorConf().Label = "" }()
+				mios.Utils.Set(others[0], 10)
+				mios.Utils.Set(others[1], 50)
+				avail := fs.GetAvail()
+				avail[others[1]].Label = "ssd"
+
+				mi := lom.LeastUtilNoCopy()
+				Expect(mi).NotTo(BeNil())
+				Expect(mi.Path).To(Equal(others[1]))
+
+				// no (more) labeled mountpaths - fall back to the others
+				mis := lom.LeastUtilNoCopyN(2)
+				Expect(mis).To(HaveLen(2))
+				Expect(mis[0].Path).To(Equal(others[1]))
+				Expect(mis[1].Path).To(Equal(others[0]))
+			})
+
+			It("should honor mirror.affinity", func() {
+				lom := prepare()
+				defer func() { lom.MirrorConf().Affinity = "" }()
+				mios.Utils.Set(others[0], 10)
+				mios.Utils.Set(others[1], 50)
+				avail := fs.GetAvail()
+				lom.Mountpath().Label = "ctrl-0"
+				avail[others[0]].Label = "ctrl-1"
+				avail[others[1]].Label = "ctrl-0"
+
+				// default: spread by utilization
+				Expect(lom.LeastUtilNoCopy().Path).To(Equal(others[0]))
+
+				lom.MirrorConf().Affinity = cmn.AffinityNear
+				Expect(lom.LeastUtilNoCopy().Path).To(Equal(others[1]))
+
+				lom.MirrorConf().Affinity = cmn.AffinityFar
+				Expect(lom.LeastUtilNoCopy().Path).To(Equal(others[0]))
+				mios.Utils.Set(others[0], 90)
+				Expect(lom.LeastUtilNoCopy().Path).To(Equal(others[0]))
+
+				// falls back when there's no preferred mountpath
+				mis := lom.LeastUtilNoCopyN(2)
+				Expect(mis).To(HaveLen(2))
+				Expect(mis[1].Path).To(Equal(others[1]))
+			})
+
+			It("should deprioritize mountpaths at or above LRU watermark", func() {
+				lom := prepare()
+				config := cmn.GCO.BeginUpdate()
+				config.Space.LowWM = 60
+				cmn.GCO.CommitUpdate(config)
+				defer func() {
+					config := cmn.GCO.BeginUpdate()
+					config.Space.LowWM = 0
+					cmn.GCO.CommitUpdate(config)
+				}()
+				mios.Utils.Set(others[0], 10)
+				mios.Utils.Set(others[1], 50)
+				setCap(others[0], 10*cos.GiB, 70)
+				setCap(others[1], 10*cos.GiB, 20)
+
+				mi := lom.LeastUtilNoCopy()
+				Expect(mi).NotTo(BeNil())
+				Expect(mi.Path).To(Equal(others[1]))
+
+				// still selectable when there's nothing else
+				mis := lom.LeastUtilNoCopyN(2)
+				Expect(mis).To(HaveLen(2))
+				Expect(mis[1].Path).To(Equal(others[0]))
+
+				// bucket-configured watermark takes precedence
+				lom.MirrorConf().LruWM = 80
+				defer func() { lom.MirrorConf().LruWM = 0 }()
+				mi = lom.LeastUtilNoCopy()
+				Expect(mi).NotTo(BeNil())
+				Expect(mi.Path).To(Equal(others[0]))
+			})
+
+			It("should avoid mountpaths that share a disk with the existing copy", func() {
+				lom := prepare()
+				mios.Utils.Set(others[0], 10)
+				mios.Utils.Set(others[1], 50)
+				avail := fs.GetAvail()
+				lom.Mountpath().Disks = []string{"sda"}
+				avail[others[0]].Disks = []string{"sda"}
+				avail[others[1]].Disks = []string{"sdb"}
+
+				mi := lom.LeastUtilNoCopy()
+				Expect(mi).NotTo(BeNil())
+				Expect(mi.Path).To(Equal(others[1]))
+
+				// same device is still better than nothing
+				mis := lom.LeastUtilNoCopyN(2)
+				Expect(mis).To(HaveLen(2))
+				Expect(mis[1].Path).To(Equal(others[0]))
+			})
+
+			It("should account for in-flight copies", func() {
+				lom := prepare()
+				mios.Utils.Set(others[0], 10)
+				mios.Utils.Set(others[1], 15)
+				avail := fs.GetAvail()
+				avail[others[0]].IncInflight()
+				defer avail[others[0]].DecInflight()
+
+				mi := lom.LeastUtilNoCopy()
+				Expect(mi).NotTo(BeNil())
+				Expect(mi.Path).To(Equal(others[1]))
+			})
+
+			It("should skip mountpaths low on free inodes", func() {
+				lom := prepare()
+				mios.Utils.Set(others[0], 10)
+				mios.Utils.Set(others[1], 50)
+				avail := fs.GetAvail()
+				avail[others[0]].TestSetInodes(cmn.DefaultMirrorInodesWM - 1)
+
+				mi := lom.LeastUtilNoCopy()
+				Expect(mi).NotTo(BeNil())
+				Expect(mi.Path).To(Equal(others[1]))
+
+				// bucket-configured threshold
+				lom.MirrorConf().InodesWM = 60
+				defer func() { lom.MirrorConf().InodesWM = 0 }()
+				avail[others[0]].TestSetInodes(70)
+				avail[others[1]].TestSetInodes(50)
+				mi = lom.LeastUtilNoCopy()
+				Expect(mi).NotTo(BeNil())
+				Expect(mi.Path).To(Equal(others[0]))
+			})
+
+			It("should skip disabled mountpaths", func() {
+				lom := prepare()
+				mios.Utils.Set(others[0], 10)
+				mios.Utils.Set(others[1], 50)
+				_, err := fs.Disable(others[0])
+				Expect(err).NotTo(HaveOccurred())
+
+				mi := lom.LeastUtilNoCopy()
+				Expect(mi).NotTo(BeNil())
+				Expect(mi.Path).To(Equal(others[1]))
+			})
+
+			It("should skip mountpaths that are being detached", func() {
+				lom := prepare()
+				mios.Utils.Set(others[0], 10)
+				mios.Utils.Set(others[1], 50)
+				_, _, _, err := fs.BeginDD(apc.ActMountpathDetach, fs.FlagBeingDetached, others[0])
+				Expect(err).NotTo(HaveOccurred())
+
+				mi := lom.LeastUtilNoCopy()
+				Expect(mi).NotTo(BeNil())
+				Expect(mi.Path).To(Equal(others[1]))
+				Expect(lom.LeastUtilNoCopyN(2)).To(HaveLen(1))
+			})
+
+			It("should return nil when all other mountpaths are nearly full", func() {
+				lom := prepare()
+				setCap(others[0], cos.MiB, 95)
+				setCap(others[1], cos.MiB, 90)
+
+				Expect(lom.LeastUtilNoCopy()).To(BeNil())
+			})
+		})
 	})
 
 	Describe("local and cloud bucket with the same name", func() {
@@ -1034,6 +1495,44 @@ var _ = Describe("LOM", func() {
 			fs.Enable(mpaths[2])
 		})
 	})
+
+	Describe("LoadLOMs", func() {
+		var (
+			names = []string{"batch/obj-1", "batch/obj-2", "batch/missing", "batch/obj-3"}
+			sizes = []int{11, 22, 0, 33}
+		)
+		BeforeEach(func() {
+			for i, name := range names {
+				lom := &core.LOM{ObjName: name}
+				Expect(lom.InitBck(&localBckA)).NotTo(HaveOccurred())
+				if sizes[i] == 0 {
+					os.Remove(lom.FQN)
+					continue
+				}
+				filePut(lom.FQN, sizes[i])
+			}
+		})
+
+		for _, parallel := range []int{1, 3} {
+			It(fmt.Sprintf("should load objects and report per-object errors (parallel=%d)", parallel), func() {
+				bck := meta.CloneBck(&localBckA)
+				loms, errs := core.LoadLOMs(bck, names, parallel)
+				Expect(loms).To(HaveLen(len(names)))
+				Expect(errs).To(HaveLen(len(names)))
+				for i := range names {
+					if sizes[i] == 0 {
+						Expect(loms[i]).To(BeNil())
+						Expect(cos.IsNotExist(errs[i], 0)).To(BeTrue())
+						continue
+					}
+					Expect(errs[i]).NotTo(HaveOccurred())
+					Expect(loms[i].ObjName).To(Equal(names[i]))
+					Expect(loms[i].SizeBytes()).To(BeEquivalentTo(sizes[i]))
+					core.FreeLOM(loms[i])
+				}
+			})
+		}
+	})
 })
 
 //
@@ -5,6 +5,8 @@
 package meta_test
 
 import (
+	"net/url"
+
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/core/meta"
@@ -25,6 +27,14 @@ var _ = Describe("Bck", func() {
 				Expect(gotBck.Provider).To(Equal(bckProvider))
 				Expect(gotBck.Ns).To(Equal(bckNs))
 				Expect(gotObjName).To(Equal(objName))
+
+				// URL-path-safe encoding
+				enc := bck.EncodeUname(objName)
+				Expect(url.PathEscape(enc)).To(Equal(enc))
+				gotBck, gotObjName, err := cmn.DecodeUname(enc)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(gotBck.Equal((*cmn.Bck)(bck))).To(BeTrue())
+				Expect(gotObjName).To(Equal(objName))
 			},
 			Entry(
 				"regular ais bucket with simple object name",
@@ -32,6 +32,14 @@ const (
 
 const SnodeMaintDecomm = SnodeMaint | SnodeDecomm
 
+// enum Snode.Caps (node capabilities - configured, see apc.NodeCapETL, et al.)
+const (
+	SnodeCapETL cos.BitFlags = 1 << iota
+	SnodeCapGPU
+)
+
+var capNames = [...]string{apc.NodeCapETL, apc.NodeCapGPU} // (in the enum order)
+
 // desirable gateway count in the Information Center (IC)
 const DfltCountIC = 3
 
@@ -71,7 +79,8 @@ type (
 		DaeType    string     `json:"daemon_type"`       // "target" or "proxy"
 		DaeID      string     `json:"daemon_id"`
 		name       string
-		Flags      cos.BitFlags `json:"flags"` // enum { SnodeNonElectable, SnodeIC, ... }
+		Flags      cos.BitFlags `json:"flags"`          // enum { SnodeNonElectable, SnodeIC, ... }
+		Caps       cos.BitFlags `json:"caps,omitempty"` // enum { SnodeCapETL, SnodeCapGPU }
 		idDigest   uint64
 	}
 
@@ -278,6 +287,33 @@ func (d *Snode) Fl2S() string {
 	return strings.Join(a, ",")
 }
 
+// node capabilities
+
+func ParseCaps(names []string) (caps cos.BitFlags, err error) {
+outer:
+	for _, name := range names {
+		for i, cn := range capNames {
+			if name == cn {
+				caps = caps.Set(1 << i)
+				continue outer
+			}
+		}
+		return 0, fmt.Errorf("invalid node capability %q (expecting one of: %v)", name, capNames)
+	}
+	return caps, nil
+}
+
+func (d *Snode) HasCaps(caps cos.BitFlags) bool { return d.Caps.IsSet(caps) }
+
+func (d *Snode) CapNames() (names []string) {
+	for i, cn := range capNames {
+		if d.Caps.IsSet(1 << i) {
+			names = append(names, cn)
+		}
+	}
+	return names
+}
+
 /////////////
 // NetInfo //
 /////////////
@@ -349,6 +385,16 @@ func (m *Smap) CountTargets() int { return len(m.Tmap) }
 func (m *Smap) CountProxies() int { return len(m.Pmap) }
 func (m *Smap) Count() int        { return len(m.Pmap) + len(m.Tmap) }
 
+// active targets that have all the specified capabilities
+func (m *Smap) TargetsWithCaps(caps cos.BitFlags) (nodes Nodes) {
+	for _, tsi := range m.Tmap {
+		if !tsi.InMaintOrDecomm() && tsi.HasCaps(caps) {
+			nodes = append(nodes, tsi)
+		}
+	}
+	return nodes
+}
+
 func (m *Smap) CountActiveTs() (count int) {
 	for _, t := range m.Tmap {
 		if !t.InMaintOrDecomm() {
@@ -0,0 +1,40 @@
+// Package meta_test: unit tests for the package
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package meta_test
+
+import (
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/core/meta"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Smap", func() {
+	Describe("node capabilities", func() {
+		It("should parse capabilities", func() {
+			caps, err := meta.ParseCaps([]string{apc.NodeCapGPU, apc.NodeCapETL})
+			Expect(err).NotTo(HaveOccurred())
+			si := &meta.Snode{Caps: caps}
+			Expect(si.HasCaps(meta.SnodeCapETL | meta.SnodeCapGPU)).To(BeTrue())
+			Expect(si.CapNames()).To(Equal([]string{apc.NodeCapETL, apc.NodeCapGPU}))
+
+			_, err = meta.ParseCaps([]string{"quantum"})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should select active targets by capabilities", func() {
+			smap := &meta.Smap{Tmap: meta.NodeMap{
+				"t1": {DaeID: "t1", DaeType: apc.Target, Caps: meta.SnodeCapETL | meta.SnodeCapGPU},
+				"t2": {DaeID: "t2", DaeType: apc.Target, Caps: meta.SnodeCapETL},
+				"t3": {DaeID: "t3", DaeType: apc.Target, Caps: meta.SnodeCapGPU, Flags: meta.SnodeMaint},
+			}}
+			gpu := smap.TargetsWithCaps(meta.SnodeCapGPU)
+			Expect(gpu).To(HaveLen(1))
+			Expect(gpu[0].ID()).To(Equal("t1"))
+			Expect(smap.TargetsWithCaps(meta.SnodeCapETL)).To(HaveLen(2))
+			Expect(smap.TargetsWithCaps(0)).To(HaveLen(2))
+		})
+	})
+})
@@ -30,6 +30,7 @@ func (*StatsTracker) AddMany(...cos.NamedVal64)     {}
 func (*StatsTracker) RegMetrics(*meta.Snode)        {}
 func (*StatsTracker) GetMetricNames() cos.StrKVs    { return nil }
 func (*StatsTracker) GetStats() *stats.Node         { return nil }
+func (*StatsTracker) GetMetrics() *stats.Node       { return nil }
 func (*StatsTracker) GetStatsV322() *stats.NodeV322 { return nil }
 func (*StatsTracker) ResetStats(bool)               {}
 func (*StatsTracker) IsPrometheus() bool            { return false }
@@ -120,5 +120,8 @@ type (
 		DryRun    bool
 		LatestVer bool // can be used without changing bucket's 'versioning.validate_warm_get'; see also: QparamLatestVer
 		Sync      bool // ditto -  bucket's 'versioning.synchronize'
+		Overwrite bool // overwrite existing destination even when identical (apc.CopyBckMsg.Overwrite)
+		SkipExist bool // skip existing destination (apc.CopyBckMsg.SkipExisting)
+		DeleteSrc bool // delete source object upon successful copy (apc.CopyBckMsg.DeleteSrc)
 	}
 )
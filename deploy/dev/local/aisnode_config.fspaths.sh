@@ -128,7 +128,8 @@ cat > $AIS_CONF_FILE <<EOL
 			"write_buffer_size": ${HTTP_WRITE_BUFFER_SIZE:-0},
 			"read_buffer_size":  ${HTTP_READ_BUFFER_SIZE:-0},
 			"chunked_transfer":  ${AIS_HTTP_CHUNKED_TRANSFER:-true},
-			"skip_verify":       ${AIS_SKIP_VERIFY_CRT:-false}
+			"skip_verify":       ${AIS_SKIP_VERIFY_CRT:-false},
+			"intra_data_tls":    ${AIS_INTRA_DATA_TLS:-false}
 		}
 	},
 	"fshc": {
@@ -8,8 +8,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/feat"
@@ -32,6 +35,27 @@ const CommTypeSeparator = "://"
 
 const DefaultTimeout = 45 * time.Second
 
+// inline (GET) transform of a single object; see also InitMsgBase.ObjTimeout
+const DefaultObjTimeout = 45 * time.Second
+
+// initial delay between retries (doubles with every attempt); see also InitMsgBase.MaxRetries
+const DefaultRetryDelay = 200 * time.Millisecond
+
+// upon termination, max time to wait for in-flight transforms; see also InitMsgBase.DrainTimeout
+const DefaultDrainTimeout = 10 * time.Second
+
+// upper limit on InitMsgBase.MaxRetries
+const maxRetries = 10
+
+// upper limit on the (doubling) delay between retries
+const maxRetryDelay = 5 * time.Second
+
+// enum content encodings (see InitMsgBase.ContentEncoding)
+const (
+	EncodingGzip    = "gzip"
+	EncodingDeflate = "deflate" // (zlib format, as per RFC 9110)
+)
+
 // enum communication types (`commTypes`)
 const (
 	// ETL container receives POST request from target with the data. It
@@ -46,6 +70,9 @@ const (
 	Hrev = "hrev://"
 	// Stdin/stdout communication.
 	HpushStdin = "io://"
+	// Target keeps a persistent WebSocket connection to the ETL container and
+	// sends it objects to transform as binary frames (see wsComm).
+	WebSocket = "ws://"
 )
 
 // enum arg types (`argTypes`)
@@ -71,6 +98,29 @@ type (
 		CommTypeX string       `json:"communication"` // enum commTypes
 		ArgTypeX  string       `json:"argument"`      // enum argTypes
 		Timeout   cos.Duration `json:"timeout"`
+		// inline (GET) transform timeout: max time to transform and send a single object (zero: DefaultObjTimeout)
+		ObjTimeout cos.Duration `json:"obj_timeout,omitempty"`
+		// hpush:// only: retry transient transform failures (connection refused or reset,
+		// 5xx from the container) up to MaxRetries times, with exponential backoff starting at RetryDelay
+		// (zero: DefaultRetryDelay); zero MaxRetries (default) - no retries.
+		// Must only be used with idempotent transforms.
+		MaxRetries int          `json:"max_retries,omitempty"`
+		RetryDelay cos.Duration `json:"retry_delay,omitempty"`
+		// hpush:// only: compress objects sent to the container (enum: EncodingGzip, EncodingDeflate);
+		// independently, responses are decompressed if the container sets Content-Encoding
+		ContentEncoding string `json:"content_encoding,omitempty"`
+		// hpush:// and io:// only: object's custom metadata keys to pass to the container
+		// (in apc.HdrObjCustomMD request headers - same as GET and HEAD responses);
+		// other keys are not exposed
+		ObjMD []string `json:"obj_md,omitempty"`
+		// HTTP connection pooling: each ETL uses its own client (and transport) to talk to its container;
+		// zero values: cmn.DefaultMaxIdleConnsPerHost, cmn.DefaultIdleConnTimeout, and unlimited MaxConnsPerHost, respectively
+		MaxIdleConnsPerHost int          `json:"max_idle_conns_per_host,omitempty"`
+		IdleConnTimeout     cos.Duration `json:"idle_conn_timeout,omitempty"`
+		MaxConnsPerHost     int          `json:"max_conns_per_host,omitempty"`
+		// upon termination (e.g., cluster membership change), max time to wait for in-flight transforms
+		// to complete before aborting them and stopping the container (zero: DefaultDrainTimeout)
+		DrainTimeout cos.Duration `json:"drain_timeout,omitempty"`
 	}
 	InitSpecMsg struct {
 		InitMsgBase
@@ -106,6 +156,23 @@ type (
 		ObjCount int64  `json:"obj_count"`
 		InBytes  int64  `json:"in_bytes"`
 		OutBytes int64  `json:"out_bytes"`
+		// compressed bytes sent and received (see InitMsgBase.ContentEncoding)
+		WireOutBytes int64 `json:"wire_out_bytes,omitempty"`
+		WireInBytes  int64 `json:"wire_in_bytes,omitempty"`
+		// number of dry-run transforms (not included in the counters above)
+		DryRunCount int64 `json:"dry_run_count,omitempty"`
+		// transform latency percentiles (see CommStats)
+		LatencyP50 time.Duration `json:"latency_p50,omitempty"`
+		LatencyP95 time.Duration `json:"latency_p95,omitempty"`
+		LatencyP99 time.Duration `json:"latency_p99,omitempty"`
+	}
+
+	// ObjRange selects a byte range of the source object to transform:
+	// `Len` bytes starting at offset `Off` (the range is clipped to the object's size);
+	// nil range means the entire object
+	ObjRange struct {
+		Off int64
+		Len int64
 	}
 
 	LogsByTarget []Logs
@@ -129,8 +196,8 @@ type (
 )
 
 var (
-	commTypes = []string{Hpush, Hpull, Hrev, HpushStdin}         // NOTE: must contain all
-	argTypes  = []string{ArgTypeDefault, ArgTypeURL, ArgTypeFQN} // ditto
+	commTypes = []string{Hpush, Hpull, Hrev, HpushStdin, WebSocket} // NOTE: must contain all
+	argTypes  = []string{ArgTypeDefault, ArgTypeURL, ArgTypeFQN}    // ditto
 )
 
 ////////////////
@@ -224,6 +291,58 @@ func (m *InitMsgBase) validate(detail string) error {
 	if m.Timeout == 0 {
 		m.Timeout = cos.Duration(DefaultTimeout)
 	}
+	if m.ObjTimeout == 0 {
+		m.ObjTimeout = cos.Duration(DefaultObjTimeout)
+	}
+	if m.DrainTimeout < 0 {
+		err := fmt.Errorf("invalid drain-timeout %v (expecting non-negative)", m.DrainTimeout)
+		return cmn.NewErrETL(errCtx, "%v [%s]", err, detail)
+	}
+	if m.DrainTimeout == 0 {
+		m.DrainTimeout = cos.Duration(DefaultDrainTimeout)
+	}
+	// retries
+	if m.MaxRetries < 0 || m.MaxRetries > maxRetries {
+		err := fmt.Errorf("invalid max-retries %d (expecting 0 <= max-retries <= %d)", m.MaxRetries, maxRetries)
+		return cmn.NewErrETL(errCtx, "%v [%s]", err, detail)
+	}
+	if m.RetryDelay < 0 {
+		err := fmt.Errorf("invalid retry-delay %v (expecting non-negative)", m.RetryDelay)
+		return cmn.NewErrETL(errCtx, "%v [%s]", err, detail)
+	}
+	if m.MaxRetries > 0 && m.RetryDelay == 0 {
+		m.RetryDelay = cos.Duration(DefaultRetryDelay)
+	}
+	// connection pooling
+	if m.MaxIdleConnsPerHost < 0 || m.MaxConnsPerHost < 0 || m.IdleConnTimeout < 0 {
+		err := fmt.Errorf("invalid connection pooling (max-idle-conns-per-host %d, max-conns-per-host %d, idle-conn-timeout %v): expecting non-negative values",
+			m.MaxIdleConnsPerHost, m.MaxConnsPerHost, m.IdleConnTimeout)
+		return cmn.NewErrETL(errCtx, "%v [%s]", err, detail)
+	}
+	// content encoding
+	switch m.ContentEncoding {
+	case "":
+	case EncodingGzip, EncodingDeflate:
+		if m.CommTypeX != Hpush || m.ArgTypeX == ArgTypeFQN {
+			err := fmt.Errorf("content-encoding %q requires comm-type %q and arg-type %q (have %q, %q)",
+				m.ContentEncoding, Hpush, ArgTypeDefault, m.CommTypeX, m.ArgTypeX)
+			return cmn.NewErrETL(errCtx, "%v [%s]", err, detail)
+		}
+	default:
+		err := fmt.Errorf("unsupported content-encoding %q (expecting %q or %q)", m.ContentEncoding, EncodingGzip, EncodingDeflate)
+		return cmn.NewErrETL(errCtx, "%v [%s]", err, detail)
+	}
+	// custom metadata
+	if len(m.ObjMD) > 0 && m.CommTypeX != Hpush && m.CommTypeX != HpushStdin {
+		err := fmt.Errorf("obj-md requires comm-type %q or %q (have %q)", Hpush, HpushStdin, m.CommTypeX)
+		return cmn.NewErrETL(errCtx, "%v [%s]", err, detail)
+	}
+	for _, k := range m.ObjMD {
+		if k == "" || strings.Contains(k, "=") {
+			err := fmt.Errorf("invalid obj-md key %q", k)
+			return cmn.NewErrETL(errCtx, "%v [%s]", err, detail)
+		}
+	}
 	return nil
 }
 
@@ -232,6 +351,9 @@ func (m *InitCodeMsg) Validate() error {
 		return err
 	}
 
+	if m.CommTypeX == WebSocket {
+		return fmt.Errorf("comm-type %q requires init spec (not supported by runtimes yet)", m.CommTypeX)
+	}
 	if len(m.Code) == 0 {
 		return fmt.Errorf("source code is empty (%q)", m.Runtime)
 	}
@@ -319,3 +441,26 @@ var _ sort.Interface = (*InfoList)(nil)
 func (il InfoList) Len() int           { return len(il) }
 func (il InfoList) Less(i, j int) bool { return il[i].Name < il[j].Name }
 func (il InfoList) Swap(i, j int)      { il[i], il[j] = il[j], il[i] }
+
+//////////////
+// ObjRange //
+//////////////
+
+// parses "offset,length" (see apc.QparamETLRange)
+func ParseObjRange(s string) (*ObjRange, error) {
+	offs, lens, ok := strings.Cut(s, ",")
+	if !ok {
+		return nil, fmt.Errorf("invalid %s %q: expecting \"offset,length\"", apc.QparamETLRange, s)
+	}
+	off, err := strconv.ParseInt(strings.TrimSpace(offs), 10, 64)
+	if err != nil || off < 0 {
+		return nil, fmt.Errorf("invalid %s %q: bad offset", apc.QparamETLRange, s)
+	}
+	length, err := strconv.ParseInt(strings.TrimSpace(lens), 10, 64)
+	if err != nil || length <= 0 {
+		return nil, fmt.Errorf("invalid %s %q: bad length", apc.QparamETLRange, s)
+	}
+	return &ObjRange{Off: off, Len: length}, nil
+}
+
+func (rng *ObjRange) String() string { return fmt.Sprintf("%d,%d", rng.Off, rng.Len) }
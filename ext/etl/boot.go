@@ -25,6 +25,9 @@ import (
 
 const appLabel = "app"
 
+// health endpoint when not specified by the readinessProbe (see also: runtime/podspec.yaml)
+const dfltHealthPath = "/health"
+
 type etlBootstrapper struct {
 	// construction
 	errCtx *cmn.ETLErrCtx
@@ -135,6 +138,41 @@ func (b *etlBootstrapper) setupConnection() (err error) {
 	return nil
 }
 
+// poll the container's health endpoint until it's ready to serve (or timeout) -
+// transforms are not routed to the ETL until then
+func (b *etlBootstrapper) waitHealthy(comm Communicator) error {
+	var (
+		timeout  = b.msg.Timeout.D()
+		interval = cos.ProbingFrequency(timeout)
+		errLast  error
+	)
+	err := wait.PollUntilContextTimeout(context.Background(), interval, timeout, true, /*immediate*/
+		func(ctx context.Context) (bool, error) {
+			ctx, cancel := context.WithTimeout(ctx, interval)
+			errLast = comm.HealthCheck(ctx)
+			cancel()
+			return errLast == nil, nil
+		},
+	)
+	if err == nil {
+		return nil
+	}
+	if errLast != nil {
+		err = errLast
+	}
+	return cmn.NewErrETL(b.errCtx, "container is not healthy: %v", err)
+}
+
+func (b *etlBootstrapper) healthPath() string {
+	if b.pod == nil || len(b.pod.Spec.Containers) == 0 {
+		return dfltHealthPath
+	}
+	if probe := b.pod.Spec.Containers[0].ReadinessProbe; probe != nil && probe.HTTPGet != nil && probe.HTTPGet.Path != "" {
+		return probe.HTTPGet.Path
+	}
+	return dfltHealthPath
+}
+
 func (b *etlBootstrapper) _dial(socketAddr string) error {
 	probeInterval := cmn.Rom.MaxKeepalive()
 	err := cmn.NetworkCallWithRetry(&cmn.RetryArgs{
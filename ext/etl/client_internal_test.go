@@ -0,0 +1,77 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Compares connection reuse: client with a small idle pool (as in http.DefaultTransport)
+// vs ETL-dedicated client sized for concurrent transforms.
+// Reports the number of TCP connections opened by the transformer's side ("conns").
+//
+// go test -bench=BenchmarkClientReuse -run=^$ ./ext/etl/
+func BenchmarkClientReuse(b *testing.B) {
+	tests := []struct {
+		name   string
+		client *http.Client
+	}{
+		{"small-idle-pool", &http.Client{Transport: &http.Transport{MaxIdleConnsPerHost: http.DefaultMaxIdleConnsPerHost}}},
+		{"etl-client", newClient(&InitMsgBase{MaxIdleConnsPerHost: 128, IdleConnTimeout: cos.Duration(time.Minute)})},
+	}
+	for _, test := range tests {
+		b.Run(test.name, func(b *testing.B) {
+			var nconns atomic.Int64
+			server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				time.Sleep(100 * time.Microsecond) // (transform)
+				_, _ = w.Write([]byte("transformed"))
+			}))
+			server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+				if state == http.StateNew {
+					nconns.Inc()
+				}
+			}
+			server.Start()
+			defer server.Close()
+			defer test.client.CloseIdleConnections()
+
+			b.SetParallelism(16)
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					resp, err := test.client.Get(server.URL)
+					if err != nil {
+						b.Error(err)
+						return
+					}
+					cos.DrainReader(resp.Body)
+					resp.Body.Close()
+				}
+			})
+			b.ReportMetric(float64(nconns.Load()), "conns")
+		})
+	}
+}
+
+func TestClientPooling(t *testing.T) {
+	msg := &InitMsgBase{MaxIdleConnsPerHost: 32, IdleConnTimeout: cos.Duration(time.Minute), MaxConnsPerHost: 8}
+	transport := newClient(msg).Transport.(*http.Transport)
+	if transport.MaxIdleConnsPerHost != 32 || transport.IdleConnTimeout != time.Minute || transport.MaxConnsPerHost != 8 {
+		t.Fatalf("unexpected transport: max-idle-conns-per-host %d, idle-conn-timeout %v, max-conns-per-host %d",
+			transport.MaxIdleConnsPerHost, transport.IdleConnTimeout, transport.MaxConnsPerHost)
+	}
+	transport = newClient(&InitMsgBase{}).Transport.(*http.Transport)
+	if transport.MaxIdleConnsPerHost == 0 || transport.IdleConnTimeout == 0 || transport.MaxConnsPerHost != 0 {
+		t.Fatalf("expecting defaults, got: max-idle-conns-per-host %d, idle-conn-timeout %v, max-conns-per-host %d",
+			transport.MaxIdleConnsPerHost, transport.IdleConnTimeout, transport.MaxConnsPerHost)
+	}
+}
@@ -5,17 +5,24 @@
 package etl
 
 import (
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
@@ -24,6 +31,7 @@ import (
 	"github.com/NVIDIA/aistore/tools/cryptorand"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"golang.org/x/net/websocket"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -52,7 +60,11 @@ var _ = Describe("CommunicatorTest", func() {
 			bck.Name, bck.Provider, bck.Ns,
 			&cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumXXHash}},
 		)
-		bmdMock = mock.NewBaseBownerMock(clusterBck)
+		remoteBck = meta.NewBck(
+			"commRemoteBck", apc.AWS, cmn.NsGlobal,
+			&cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumXXHash}},
+		)
+		bmdMock = mock.NewBaseBownerMock(clusterBck, remoteBck)
 	)
 
 	BeforeEach(func() {
@@ -91,7 +103,7 @@ var _ = Describe("CommunicatorTest", func() {
 			Expect(err).NotTo(HaveOccurred())
 		}))
 		targetServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			err := comm.InlineTransform(w, r, clusterBck, objName)
+			err := comm.InlineTransform(w, r, clusterBck, objName, nil)
 			Expect(err).NotTo(HaveOccurred())
 		}))
 		proxyServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -128,7 +140,9 @@ var _ = Describe("CommunicatorTest", func() {
 				uri:  transformerServer.URL,
 				xctn: xctn,
 			}
-			comm = newCommunicator(nil, boot)
+			var err error
+			comm, err = newCommunicator(nil, boot)
+			Expect(err).NotTo(HaveOccurred())
 
 			resp, err := http.Get(proxyServer.URL)
 			Expect(err).NotTo(HaveOccurred())
@@ -140,6 +154,816 @@ var _ = Describe("CommunicatorTest", func() {
 			Expect(b).To(Equal(transformData))
 		})
 	}
+
+	Describe("retries", func() {
+		var (
+			flakyServer *httptest.Server
+			attempts    atomic.Int32
+		)
+		// fails the first `nfail` requests with 503
+		newComm := func(nfail int32, maxRetries int) Communicator {
+			attempts.Store(0)
+			flakyServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.Copy(io.Discard, r.Body)
+				if attempts.Inc() <= nfail {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				_, err := w.Write(transformData)
+				Expect(err).NotTo(HaveOccurred())
+			}))
+			pod := &corev1.Pod{}
+			pod.SetName("somename")
+			boot := &etlBootstrapper{
+				msg: InitSpecMsg{InitMsgBase: InitMsgBase{
+					CommTypeX:  Hpush,
+					MaxRetries: maxRetries,
+					RetryDelay: cos.Duration(10 * time.Millisecond),
+				}},
+				pod:  pod,
+				uri:  flakyServer.URL,
+				xctn: mock.NewXact(apc.ActETLInline),
+			}
+			comm, err := newCommunicator(nil, boot)
+			Expect(err).NotTo(HaveOccurred())
+			return comm
+		}
+		transform := func(comm Communicator) ([]byte, error) {
+			r, err := comm.OfflineTransform(clusterBck, objName, 0, nil)
+			if err != nil {
+				return nil, err
+			}
+			defer r.Close()
+			return io.ReadAll(r)
+		}
+
+		AfterEach(func() {
+			flakyServer.Close()
+		})
+
+		It("should succeed on the second attempt", func() {
+			b, err := transform(newComm(1, 2))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(b).To(Equal(transformData))
+			Expect(attempts.Load()).To(Equal(int32(2)))
+		})
+
+		It("should give up after max retries", func() {
+			_, err := transform(newComm(10, 2))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("503"))
+			Expect(attempts.Load()).To(Equal(int32(3)))
+		})
+
+		It("should not retry by default", func() {
+			_, err := transform(newComm(1, 0))
+			Expect(err).To(HaveOccurred())
+			Expect(attempts.Load()).To(Equal(int32(1)))
+		})
+
+		It("should not retry non-existing object", func() {
+			comm := newComm(0, 2)
+			_, err := comm.OfflineTransform(clusterBck, "does-not-exist", 0, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(cos.IsNotExist(err, 0)).To(BeTrue())
+			Expect(attempts.Load()).To(Equal(int32(0)))
+		})
+
+		It("should not hold object lock while waiting to retry", func() {
+			comm := newComm(10, 2).(*pushComm)
+			comm.boot.msg.RetryDelay = cos.Duration(time.Minute)
+			comm.boot.msg.DrainTimeout = 0
+			done := make(chan error, 1)
+			go func() {
+				_, err := transform(comm)
+				done <- err
+			}()
+			Eventually(attempts.Load).Should(Equal(int32(1)))
+
+			lom := &core.LOM{ObjName: objName}
+			Expect(lom.InitBck(clusterBck.Bucket())).NotTo(HaveOccurred())
+			Eventually(func() bool {
+				if lom.TryLock(true) {
+					lom.Unlock(true)
+					return true
+				}
+				return false
+			}).Should(BeTrue())
+
+			// (drain timeout zero) abort interrupts the wait
+			Expect(comm.Drain()).To(HaveOccurred())
+			var err error
+			Eventually(done).Should(Receive(&err))
+			Expect(errors.Is(err, errDrainTimeout)).To(BeTrue(), "%v", err)
+			Expect(attempts.Load()).To(Equal(int32(1)))
+		})
+	})
+
+	for _, enc := range []string{EncodingGzip, EncodingDeflate} {
+		It("should round-trip "+enc+"-encoded transformation", func() {
+			var (
+				zreader = func(r io.Reader) (io.ReadCloser, error) {
+					if enc == EncodingGzip {
+						return gzip.NewReader(r)
+					}
+					return zlib.NewReader(r)
+				}
+				zwriter = func(w io.Writer) io.WriteCloser {
+					if enc == EncodingGzip {
+						return gzip.NewWriter(w)
+					}
+					return zlib.NewWriter(w)
+				}
+			)
+			lom := &core.LOM{ObjName: objName}
+			Expect(lom.InitBck(clusterBck.Bucket())).NotTo(HaveOccurred())
+			objData, err := os.ReadFile(lom.FQN)
+			Expect(err).NotTo(HaveOccurred())
+
+			zServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				Expect(r.Header.Get(cos.HdrContentEncoding)).To(Equal(enc))
+				zr, err := zreader(r.Body)
+				Expect(err).NotTo(HaveOccurred())
+				b, err := io.ReadAll(zr)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(b).To(Equal(objData))
+
+				w.Header().Set(cos.HdrContentEncoding, enc)
+				zw := zwriter(w)
+				_, err = zw.Write(transformData)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(zw.Close()).NotTo(HaveOccurred())
+			}))
+			defer zServer.Close()
+
+			pod := &corev1.Pod{}
+			pod.SetName("somename")
+			boot := &etlBootstrapper{
+				msg:  InitSpecMsg{InitMsgBase: InitMsgBase{CommTypeX: Hpush, ContentEncoding: enc}},
+				pod:  pod,
+				uri:  zServer.URL,
+				xctn: mock.NewXact(apc.ActETLInline),
+			}
+			comm, err := newCommunicator(nil, boot)
+			Expect(err).NotTo(HaveOccurred())
+
+			r, err := comm.OfflineTransform(clusterBck, objName, 0, nil)
+			Expect(err).NotTo(HaveOccurred())
+			b, err := io.ReadAll(r)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.Close()).NotTo(HaveOccurred())
+			Expect(b).To(Equal(transformData))
+
+			Expect(comm.OutBytes()).To(Equal(dataSize))
+			Expect(comm.InBytes()).To(Equal(dataSize))
+			Expect(comm.WireOutBytes()).To(BeNumerically(">", 0))
+			Expect(comm.WireInBytes()).To(BeNumerically(">", 0))
+		})
+	}
+
+	It("should pass declared custom metadata", func() {
+		lom := &core.LOM{ObjName: objName}
+		Expect(lom.InitBck(clusterBck.Bucket())).NotTo(HaveOccurred())
+		Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+		lom.SetCustomKey("label", "cat")
+		lom.SetCustomKey("secret", "do-not-pass")
+		Expect(lom.Persist()).NotTo(HaveOccurred())
+
+		var custom []string
+		mdServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.Copy(io.Discard, r.Body)
+			custom = r.Header.Values(apc.HdrObjCustomMD)
+			_, err := w.Write(transformData)
+			Expect(err).NotTo(HaveOccurred())
+		}))
+		defer mdServer.Close()
+
+		pod := &corev1.Pod{}
+		pod.SetName("somename")
+		boot := &etlBootstrapper{
+			msg:  InitSpecMsg{InitMsgBase: InitMsgBase{CommTypeX: Hpush, ObjMD: []string{"label", "missing"}}},
+			pod:  pod,
+			uri:  mdServer.URL,
+			xctn: mock.NewXact(apc.ActETLInline),
+		}
+		comm, err := newCommunicator(nil, boot)
+		Expect(err).NotTo(HaveOccurred())
+
+		r, err := comm.OfflineTransform(clusterBck, objName, 0, nil)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = io.Copy(io.Discard, r)
+		Expect(err).NotTo(HaveOccurred())
+		r.Close()
+		Expect(custom).To(Equal([]string{"label=cat"}))
+	})
+
+	It("should dry-run without contacting container", func() {
+		var ncalls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ncalls.Inc()
+			_, _ = io.Copy(io.Discard, r.Body)
+			_, _ = w.Write(transformData)
+		}))
+		defer server.Close()
+
+		pod := &corev1.Pod{}
+		pod.SetName("somename")
+		boot := &etlBootstrapper{
+			msg:  InitSpecMsg{InitMsgBase: InitMsgBase{CommTypeX: Hpush}},
+			pod:  pod,
+			uri:  server.URL,
+			xctn: mock.NewXact(apc.ActETLInline),
+		}
+		comm, err := newCommunicator(nil, boot)
+		Expect(err).NotTo(HaveOccurred())
+		dp := &OfflineDP{comm: comm, tcbmsg: &apc.TCBMsg{CopyBckMsg: apc.CopyBckMsg{DryRun: true}}}
+
+		lom := &core.LOM{ObjName: objName}
+		Expect(lom.InitBck(clusterBck.Bucket())).NotTo(HaveOccurred())
+		size, err := dp.DryRun(lom)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(size).To(Equal(dataSize))
+
+		_, err = comm.OfflineTransformDryRun(clusterBck, "does-not-exist")
+		Expect(cos.IsErrNotFound(err)).To(BeTrue())
+
+		Expect(ncalls.Load()).To(BeZero())
+		Expect(comm.DryRunCount()).To(Equal(int64(1)))
+		Expect(comm.InBytes()).To(BeZero())
+		Expect(comm.OutBytes()).To(BeZero())
+	})
+
+	Describe("byte range", func() {
+		var (
+			rangeHdr string
+			server   *httptest.Server
+		)
+		BeforeEach(func() {
+			rangeHdr = ""
+			// echo the request body (hpush) or the requested range of the source (hrev)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPut {
+					b, err := io.ReadAll(r.Body)
+					Expect(err).NotTo(HaveOccurred())
+					_, err = w.Write(b)
+					Expect(err).NotTo(HaveOccurred())
+					return
+				}
+				rangeHdr = r.Header.Get(cos.HdrRange)
+				_, err := w.Write([]byte("ranged"))
+				Expect(err).NotTo(HaveOccurred())
+			}))
+		})
+		AfterEach(func() {
+			server.Close()
+		})
+
+		newComm := func(commType string) Communicator {
+			pod := &corev1.Pod{}
+			pod.SetName("somename")
+			boot := &etlBootstrapper{
+				msg:  InitSpecMsg{InitMsgBase: InitMsgBase{CommTypeX: commType}},
+				pod:  pod,
+				uri:  server.URL,
+				xctn: mock.NewXact(apc.ActETLInline),
+			}
+			comm, err := newCommunicator(nil, boot)
+			Expect(err).NotTo(HaveOccurred())
+			return comm
+		}
+		source := func() []byte {
+			lom := &core.LOM{ObjName: objName}
+			Expect(lom.InitBck(clusterBck.Bucket())).NotTo(HaveOccurred())
+			b, err := os.ReadFile(lom.FQN)
+			Expect(err).NotTo(HaveOccurred())
+			return b
+		}
+
+		It("should push only the requested range", func() {
+			comm := newComm(Hpush)
+			rng := &ObjRange{Off: cos.MiB, Len: 3 * cos.KiB}
+			r, err := comm.OfflineTransform(clusterBck, objName, 0, rng)
+			Expect(err).NotTo(HaveOccurred())
+			b, err := io.ReadAll(r)
+			Expect(err).NotTo(HaveOccurred())
+			r.Close()
+			Expect(b).To(Equal(source()[rng.Off : rng.Off+rng.Len]))
+			Expect(comm.OutBytes()).To(Equal(rng.Len))
+		})
+
+		It("should clip range to object size", func() {
+			comm := newComm(Hpush)
+			rng := &ObjRange{Off: dataSize - 100, Len: cos.MiB}
+			r, err := comm.OfflineTransform(clusterBck, objName, 0, rng)
+			Expect(err).NotTo(HaveOccurred())
+			b, err := io.ReadAll(r)
+			Expect(err).NotTo(HaveOccurred())
+			r.Close()
+			Expect(b).To(Equal(source()[dataSize-100:]))
+		})
+
+		It("should fail when range is not satisfiable", func() {
+			for _, commType := range []string{Hpush, Hrev} {
+				comm := newComm(commType)
+				_, err := comm.OfflineTransform(clusterBck, objName, 0, &ObjRange{Off: dataSize, Len: 1})
+				Expect(cmn.IsErrRangeNotSatisfiable(err)).To(BeTrue(), commType)
+			}
+		})
+
+		It("should request range from "+Hrev, func() {
+			comm := newComm(Hrev)
+			r, err := comm.OfflineTransform(clusterBck, objName, 0, &ObjRange{Off: 10, Len: 20})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = io.Copy(io.Discard, r)
+			Expect(err).NotTo(HaveOccurred())
+			r.Close()
+			Expect(rangeHdr).To(Equal("bytes=10-29"))
+		})
+
+		It("should parse range", func() {
+			rng, err := ParseObjRange("100, 200")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*rng).To(Equal(ObjRange{Off: 100, Len: 200}))
+			for _, s := range []string{"", "100", "-1,10", "10,0", "a,b"} {
+				_, err = ParseObjRange(s)
+				Expect(err).To(HaveOccurred(), s)
+			}
+		})
+	})
+
+	Describe("Drain", func() {
+		var (
+			server  *httptest.Server
+			release chan struct{}
+		)
+		BeforeEach(func() {
+			release = make(chan struct{})
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.Copy(io.Discard, r.Body)
+				select {
+				case <-release:
+				case <-r.Context().Done():
+					return
+				}
+				_, _ = w.Write([]byte("transformed"))
+			}))
+		})
+		AfterEach(func() {
+			close(release)
+			server.Close()
+		})
+
+		newComm := func(drainTimeout time.Duration) *pushComm {
+			pod := &corev1.Pod{}
+			pod.SetName("somename")
+			boot := &etlBootstrapper{
+				msg:  InitSpecMsg{InitMsgBase: InitMsgBase{CommTypeX: Hpush, DrainTimeout: cos.Duration(drainTimeout)}},
+				pod:  pod,
+				uri:  server.URL,
+				xctn: mock.NewXact(apc.ActETLInline),
+			}
+			comm, err := newCommunicator(nil, boot)
+			Expect(err).NotTo(HaveOccurred())
+			return comm.(*pushComm)
+		}
+		transform := func(comm Communicator) chan error {
+			ch := make(chan error, 1)
+			go func() {
+				r, err := comm.OfflineTransform(clusterBck, objName, 0, nil)
+				if err == nil {
+					_, err = io.Copy(io.Discard, r)
+					r.Close()
+				}
+				ch <- err
+			}()
+			return ch
+		}
+
+		It("should wait for in-flight transforms to complete", func() {
+			comm := newComm(10 * time.Second)
+			inflight := transform(comm)
+			Eventually(comm.Inflight).Should(Equal(int64(1)))
+
+			drained := make(chan error, 1)
+			go func() { drained <- comm.Drain() }()
+			Eventually(comm.draining.Load).Should(BeTrue())
+
+			_, err := comm.OfflineTransform(clusterBck, objName, 0, nil)
+			Expect(IsErrDraining(err)).To(BeTrue())
+			Consistently(drained, 100*time.Millisecond).ShouldNot(Receive())
+
+			release <- struct{}{}
+			Eventually(inflight).Should(Receive(BeNil()))
+			Eventually(drained).Should(Receive(BeNil()))
+			Expect(comm.Inflight()).To(BeZero())
+		})
+
+		It("should abort in-flight transforms upon drain timeout", func() {
+			comm := newComm(100 * time.Millisecond)
+			inflight := []chan error{transform(comm), transform(comm)}
+			Eventually(comm.Inflight).Should(Equal(int64(2)))
+
+			started := time.Now()
+			err := comm.Drain()
+			Expect(errors.Is(err, errDrainTimeout)).To(BeTrue())
+			Expect(time.Since(started)).To(BeNumerically(">=", 100*time.Millisecond))
+			for _, ch := range inflight {
+				var errT error
+				Eventually(ch).Should(Receive(&errT))
+				Expect(errors.Is(errT, errDrainTimeout)).To(BeTrue(), "%v", errT)
+			}
+			Eventually(comm.Inflight).Should(BeZero())
+		})
+
+		It("should keep offline transform in flight until closed", func() {
+			comm := newComm(time.Second)
+			close(release)
+			defer func() { release = make(chan struct{}) }()
+
+			r, err := comm.OfflineTransform(clusterBck, objName, 0, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(comm.Inflight()).To(Equal(int64(1)))
+			_, err = io.Copy(io.Discard, r)
+			Expect(err).NotTo(HaveOccurred())
+			r.Close()
+			r.Close()
+			Expect(comm.Inflight()).To(BeZero())
+			Expect(comm.Drain()).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("lomLoad", func() {
+		It("should return size of existing object", func() {
+			lom := &core.LOM{ObjName: objName}
+			size, err := lomLoad(lom, clusterBck)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(size).To(Equal(dataSize))
+		})
+
+		It("should return not-found error for missing object in ais bucket", func() {
+			lom := &core.LOM{ObjName: "does-not-exist"}
+			_, err := lomLoad(lom, clusterBck)
+			Expect(err).To(HaveOccurred())
+			Expect(cos.IsErrNotFound(err)).To(BeTrue())
+		})
+
+		It("should fall through for missing object in remote bucket", func() {
+			lom := &core.LOM{ObjName: "does-not-exist"}
+			size, err := lomLoad(lom, remoteBck)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(size).To(BeZero())
+		})
+
+		for _, commType := range []string{Hpull, Hrev} {
+			It("should not transform missing object "+commType, func() {
+				pod := &corev1.Pod{}
+				pod.SetName("somename")
+				boot := &etlBootstrapper{
+					msg:  InitSpecMsg{InitMsgBase: InitMsgBase{CommTypeX: commType}},
+					pod:  pod,
+					uri:  transformerServer.URL,
+					xctn: mock.NewXact(apc.ActETLInline),
+				}
+				comm, err := newCommunicator(nil, boot)
+				Expect(err).NotTo(HaveOccurred())
+
+				w := httptest.NewRecorder()
+				err = comm.InlineTransform(w, httptest.NewRequest(http.MethodGet, "/", http.NoBody), clusterBck, "does-not-exist", nil)
+				Expect(err).To(HaveOccurred())
+				Expect(cos.IsErrNotFound(err)).To(BeTrue())
+				Expect(w.Code).NotTo(Equal(http.StatusTemporaryRedirect))
+			})
+		}
+	})
+
+	Describe("latency", func() {
+		It("should report zero when empty", func() {
+			var lat latency
+			Expect(lat.percentile(50)).To(BeZero())
+			Expect(lat.percentile(99)).To(BeZero())
+		})
+
+		It("should compute percentiles", func() {
+			var lat latency
+			for _, i := range rand.Perm(100) {
+				lat.add(time.Duration(i+1) * time.Millisecond)
+			}
+			Expect(lat.percentile(50)).To(Equal(50 * time.Millisecond))
+			Expect(lat.percentile(95)).To(Equal(95 * time.Millisecond))
+			Expect(lat.percentile(99)).To(Equal(99 * time.Millisecond))
+			Expect(lat.percentile(100)).To(Equal(100 * time.Millisecond))
+		})
+
+		It("should only keep the most recent samples", func() {
+			var lat latency
+			for range latWindow {
+				lat.add(time.Hour)
+			}
+			for range latWindow {
+				lat.add(time.Second)
+			}
+			Expect(lat.percentile(99)).To(Equal(time.Second))
+		})
+
+		It("should record transform latency", func() {
+			pod := &corev1.Pod{}
+			pod.SetName("somename")
+			boot := &etlBootstrapper{
+				msg:  InitSpecMsg{InitMsgBase: InitMsgBase{CommTypeX: Hpush}},
+				pod:  pod,
+				uri:  transformerServer.URL,
+				xctn: mock.NewXact(apc.ActETLInline),
+			}
+			comm, err := newCommunicator(nil, boot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(comm.LatencyP50()).To(BeZero())
+
+			r, err := comm.OfflineTransform(clusterBck, objName, 0, nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = io.Copy(io.Discard, r)
+			Expect(err).NotTo(HaveOccurred())
+			r.Close()
+			Expect(comm.LatencyP50()).To(BeNumerically(">", 0))
+			Expect(comm.LatencyP99()).To(Equal(comm.LatencyP50()))
+		})
+	})
+
+	Describe("newCommunicator", func() {
+		newBoot := func(commType, uri string) *etlBootstrapper {
+			pod := &corev1.Pod{}
+			pod.SetName("somename")
+			return &etlBootstrapper{
+				msg:  InitSpecMsg{InitMsgBase: InitMsgBase{CommTypeX: commType}},
+				pod:  pod,
+				uri:  uri,
+				xctn: mock.NewXact(apc.ActETLInline),
+			}
+		}
+
+		It("should fail on invalid transformer URL", func() {
+			comm, err := newCommunicator(nil, newBoot(Hrev, "http://[::1:8080"))
+			Expect(err).To(HaveOccurred())
+			var errETL *cmn.ErrETL
+			Expect(errors.As(err, &errETL)).To(BeTrue())
+			Expect(comm).To(BeNil())
+		})
+
+		It("should fail on unknown comm-type", func() {
+			comm, err := newCommunicator(nil, newBoot("foo://", "http://localhost:8080"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("foo://"))
+			Expect(comm).To(BeNil())
+		})
+	})
+
+	Describe("HealthCheck", func() {
+		var healthServer *httptest.Server
+
+		newComm := func(handler http.HandlerFunc) Communicator {
+			healthServer = httptest.NewServer(handler)
+			pod := &corev1.Pod{}
+			pod.SetName("somename")
+			boot := &etlBootstrapper{
+				msg:  InitSpecMsg{InitMsgBase: InitMsgBase{CommTypeX: Hpush}},
+				pod:  pod,
+				uri:  healthServer.URL,
+				xctn: mock.NewXact(apc.ActETLInline),
+			}
+			comm, err := newCommunicator(nil, boot)
+			Expect(err).NotTo(HaveOccurred())
+			return comm
+		}
+
+		AfterEach(func() {
+			healthServer.Close()
+		})
+
+		It("should report healthy container", func() {
+			comm := newComm(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal(dfltHealthPath))
+				w.WriteHeader(http.StatusOK)
+			})
+			Expect(comm.HealthCheck(context.Background())).NotTo(HaveOccurred())
+		})
+
+		It("should report unhealthy container", func() {
+			comm := newComm(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			})
+			err := comm.HealthCheck(context.Background())
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("503"))
+		})
+
+		It("should time out when container does not respond", func() {
+			comm := newComm(func(_ http.ResponseWriter, r *http.Request) {
+				select {
+				case <-time.After(2 * time.Second):
+				case <-r.Context().Done():
+				}
+			})
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			err := comm.HealthCheck(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		})
+	})
+
+	Describe("WebSocket", func() {
+		var (
+			wsServer *httptest.Server
+			nconns   atomic.Int32
+		)
+		// `handle` returns response status and payload
+		newComm := func(handle func(conn *websocket.Conn, frame []byte) (byte, []byte), objTimeout time.Duration) Communicator {
+			nconns.Store(0)
+			mux := http.NewServeMux()
+			mux.Handle(wsPath, websocket.Handler(func(conn *websocket.Conn) {
+				nconns.Inc()
+				conn.MaxPayloadBytes = maxWsFrameSize
+				for {
+					var frame []byte
+					if err := websocket.Message.Receive(conn, &frame); err != nil {
+						return
+					}
+					go func(frame []byte) {
+						status, payload := handle(conn, frame)
+						if payload == nil {
+							return
+						}
+						resp := append(frame[:wsReqHdrSize:wsReqHdrSize], status)
+						_ = websocket.Message.Send(conn, append(resp, payload...))
+					}(frame)
+				}
+			}))
+			wsServer = httptest.NewServer(mux)
+			pod := &corev1.Pod{}
+			pod.SetName("somename")
+			boot := &etlBootstrapper{
+				msg:  InitSpecMsg{InitMsgBase: InitMsgBase{CommTypeX: WebSocket, ObjTimeout: cos.Duration(objTimeout)}},
+				pod:  pod,
+				uri:  wsServer.URL,
+				xctn: mock.NewXact(apc.ActETLInline),
+			}
+			comm, err := newCommunicator(nil, boot)
+			Expect(err).NotTo(HaveOccurred())
+			return comm
+		}
+		echo := func(_ *websocket.Conn, frame []byte) (byte, []byte) {
+			return wsOK, frame[wsReqHdrSize:]
+		}
+		createObj := func(name string, size int64) []byte {
+			lom := &core.LOM{ObjName: name}
+			Expect(lom.InitBck(clusterBck.Bucket())).NotTo(HaveOccurred())
+			Expect(createRandomFile(lom.FQN, size)).NotTo(HaveOccurred())
+			lom.SetAtimeUnix(time.Now().UnixNano())
+			lom.SetSize(size)
+			Expect(lom.Persist()).NotTo(HaveOccurred())
+			b, err := os.ReadFile(lom.FQN)
+			Expect(err).NotTo(HaveOccurred())
+			return b
+		}
+		transform := func(comm Communicator, name string) ([]byte, error) {
+			r, err := comm.OfflineTransform(clusterBck, name, 0, nil)
+			if err != nil {
+				return nil, err
+			}
+			defer r.Close()
+			return io.ReadAll(r)
+		}
+
+		AfterEach(func() {
+			comm.(*wsComm).close()
+			wsServer.Close()
+		})
+
+		It("should perform transformation", func() {
+			comm = newComm(echo, 0)
+			w := httptest.NewRecorder()
+			err := comm.InlineTransform(w, httptest.NewRequest(http.MethodGet, "/", http.NoBody), clusterBck, objName, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			lom := &core.LOM{ObjName: objName}
+			Expect(lom.InitBck(clusterBck.Bucket())).NotTo(HaveOccurred())
+			b, err := os.ReadFile(lom.FQN)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(w.Body.Bytes()).To(Equal(b))
+			Expect(comm.OutBytes()).To(Equal(dataSize))
+			Expect(comm.InBytes()).To(Equal(dataSize))
+		})
+
+		It("should correlate concurrent transforms", func() {
+			const num = 8
+			// respond in reverse order
+			comm = newComm(func(_ *websocket.Conn, frame []byte) (byte, []byte) {
+				time.Sleep(time.Duration(num-frame[wsReqHdrSize]) * 20 * time.Millisecond)
+				return wsOK, frame[wsReqHdrSize:]
+			}, 0)
+			objs := make([][]byte, num)
+			for i := range num {
+				objs[i] = createObj(fmt.Sprintf("obj-%d", i), 1024)
+				objs[i][0] = byte(i)
+				lom := &core.LOM{ObjName: fmt.Sprintf("obj-%d", i)}
+				Expect(lom.InitBck(clusterBck.Bucket())).NotTo(HaveOccurred())
+				Expect(os.WriteFile(lom.FQN, objs[i], cos.PermRWR)).NotTo(HaveOccurred())
+			}
+			var wg sync.WaitGroup
+			for i := range num {
+				wg.Add(1)
+				go func(i int) {
+					defer GinkgoRecover()
+					defer wg.Done()
+					b, err := transform(comm, fmt.Sprintf("obj-%d", i))
+					Expect(err).NotTo(HaveOccurred())
+					Expect(b).To(Equal(objs[i]))
+				}(i)
+			}
+			wg.Wait()
+			Expect(nconns.Load()).To(Equal(int32(1)))
+		})
+
+		It("should fail upon transform error", func() {
+			comm = newComm(func(_ *websocket.Conn, _ []byte) (byte, []byte) {
+				return 1, []byte("bad input")
+			}, 0)
+			_, err := transform(comm, objName)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("bad input"))
+		})
+
+		It("should reconnect", func() {
+			comm = newComm(func(conn *websocket.Conn, frame []byte) (byte, []byte) {
+				if nconns.Load() == 1 {
+					conn.Close() // drop the first connection without responding
+					return wsOK, nil
+				}
+				return wsOK, frame[wsReqHdrSize:]
+			}, 0)
+			_, err := transform(comm, objName)
+			Expect(err).To(HaveOccurred())
+
+			_, err = transform(comm, objName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nconns.Load()).To(Equal(int32(2)))
+		})
+
+		It("should time out slow transformation", func() {
+			const objTimeout = 100 * time.Millisecond
+			comm = newComm(func(_ *websocket.Conn, _ []byte) (byte, []byte) {
+				return wsOK, nil // never respond
+			}, objTimeout)
+			w := httptest.NewRecorder()
+			err := comm.InlineTransform(w, httptest.NewRequest(http.MethodGet, "/", http.NoBody), clusterBck, objName, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(IsErrObjTimeout(err)).To(BeTrue())
+		})
+	})
+
+	for _, commType := range []string{Hpush, Hrev} {
+		It("should time out slow transformation "+commType, func() {
+			const (
+				objTimeout = 100 * time.Millisecond
+				delay      = 2 * time.Second
+			)
+			slowServer := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				_, _ = io.Copy(io.Discard, r.Body)
+				select {
+				case <-time.After(delay):
+				case <-r.Context().Done():
+				}
+			}))
+			defer slowServer.Close()
+
+			pod := &corev1.Pod{}
+			pod.SetName("somename")
+			boot := &etlBootstrapper{
+				msg: InitSpecMsg{
+					InitMsgBase: InitMsgBase{
+						CommTypeX:  commType,
+						ObjTimeout: cos.Duration(objTimeout),
+					},
+				},
+				pod:  pod,
+				uri:  slowServer.URL,
+				xctn: mock.NewXact(apc.ActETLInline),
+			}
+			var err error
+			comm, err = newCommunicator(nil, boot)
+			Expect(err).NotTo(HaveOccurred())
+
+			var (
+				w       = httptest.NewRecorder()
+				r       = httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+				started = time.Now()
+			)
+			err = comm.InlineTransform(w, r, clusterBck, objName, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(IsErrObjTimeout(err)).To(BeTrue())
+			Expect(time.Since(started)).To(BeNumerically("<", delay))
+		})
+	}
 })
 
 // Creates a file with random content.
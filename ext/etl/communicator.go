@@ -5,7 +5,10 @@
 package etl
 
 import (
+	"compress/gzip"
+	"compress/zlib"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -16,6 +19,7 @@ import (
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
@@ -29,6 +33,24 @@ type (
 		ObjCount() int64
 		InBytes() int64
 		OutBytes() int64
+
+		// transform latency percentiles over the most recent (successful) transforms:
+		// from sending the request to receiving the container's response headers
+		// (ws://: the entire response); zero when there's nothing to report
+		LatencyP50() time.Duration
+		LatencyP95() time.Duration
+		LatencyP99() time.Duration
+
+		// compressed bytes sent to and received from the container (see InitMsgBase.ContentEncoding);
+		// uncompressed bytes are accounted by OutBytes and InBytes
+		WireOutBytes() int64
+		WireInBytes() int64
+
+		// number of dry-run transforms (see OfflineTransformDryRun); not included in any of the above
+		DryRunCount() int64
+
+		// number of transforms currently in flight (see Drain)
+		Inflight() int64
 	}
 
 	// Communicator is responsible for managing communications with local ETL container.
@@ -46,13 +68,30 @@ type (
 		// InlineTransform uses one of the two ETL container endpoints:
 		//  - Method "PUT", Path "/"
 		//  - Method "GET", Path "/bucket/object"
-		InlineTransform(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) error
+		// The transform is bounded by InitMsgBase.ObjTimeout (except Hpull that merely redirects) -
+		// when exceeded, returns error that wraps context.DeadlineExceeded (see IsErrObjTimeout).
+		// Optional `rng` selects a byte range of the source object to transform (see ObjRange).
+		InlineTransform(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, rng *ObjRange) error
 
 		// OfflineTransform interface implementations realize offline ETL.
 		// OfflineTransform is driven by `OfflineDP` - not to confuse
 		// with GET requests from users (such as training models and apps)
 		// to perform on-the-fly transformation.
-		OfflineTransform(bck *meta.Bck, objName string, timeout time.Duration) (cos.ReadCloseSizer, error)
+		OfflineTransform(bck *meta.Bck, objName string, timeout time.Duration, rng *ObjRange) (cos.ReadCloseSizer, error)
+
+		// OfflineTransformDryRun does not contact ETL container - returns the size
+		// of the source object as the (estimated) size of the transformed one
+		// (zero when the object is remote and not present in the cluster)
+		OfflineTransformDryRun(bck *meta.Bck, objName string) (int64, error)
+
+		// HealthCheck GETs the container's health endpoint (the path of its readinessProbe);
+		// nil error means the container is ready to transform
+		HealthCheck(ctx context.Context) error
+
+		// Drain stops accepting new transforms and waits up to InitMsgBase.DrainTimeout
+		// for in-flight ones to complete, aborting the rest; called prior to Stop
+		Drain() error
+
 		Stop()
 
 		CommStats
@@ -61,10 +100,23 @@ type (
 	baseComm struct {
 		listener meta.Slistener
 		boot     *etlBootstrapper
+		client   *http.Client // dedicated to the ETL container (see InitMsgBase.MaxIdleConnsPerHost)
+		lat      latency
+		wire     struct {
+			out atomic.Int64
+			in  atomic.Int64
+		}
+		ndry atomic.Int64
+		// graceful termination (see Drain)
+		ctx      context.Context
+		abort    context.CancelCauseFunc
+		inflight atomic.Int64
+		draining atomic.Bool
 	}
 	pushComm struct {
 		baseComm
 		command []string
+		avgSize cos.SizeEWMA // transformed object sizes
 	}
 	redirectComm struct {
 		baseComm
@@ -74,10 +126,13 @@ type (
 		rp *httputil.ReverseProxy
 	}
 
-	// TODO: Generalize and move to `cos` package
-	cbWriter struct {
-		w       io.Writer
-		writeCb func(int)
+	// (request context key: set upon timeout - see revProxyComm.InlineTransform)
+	rpTimedOutKey struct{}
+
+	// decompressing reader that also closes the response body
+	zreader struct {
+		io.ReadCloser
+		body io.Closer
 	}
 )
 
@@ -86,33 +141,33 @@ var (
 	_ Communicator = (*pushComm)(nil)
 	_ Communicator = (*redirectComm)(nil)
 	_ Communicator = (*revProxyComm)(nil)
-
-	_ io.Writer = (*cbWriter)(nil)
 )
 
 //////////////
 // baseComm //
 //////////////
 
-func newCommunicator(listener meta.Slistener, boot *etlBootstrapper) Communicator {
+func newCommunicator(listener meta.Slistener, boot *etlBootstrapper) (Communicator, error) {
 	switch boot.msg.CommTypeX {
 	case Hpush, HpushStdin:
 		pc := &pushComm{}
-		pc.listener, pc.boot = listener, boot
+		pc.init(listener, boot)
 		if boot.msg.CommTypeX == HpushStdin { // io://
 			pc.command = boot.originalCommand
 		}
-		return pc
+		return pc, nil
 	case Hpull:
 		rc := &redirectComm{}
-		rc.listener, rc.boot = listener, boot
-		return rc
+		rc.init(listener, boot)
+		return rc, nil
 	case Hrev:
 		rp := &revProxyComm{}
-		rp.listener, rp.boot = listener, boot
+		rp.init(listener, boot)
 
 		transformerURL, err := url.Parse(boot.uri)
-		debug.AssertNoErr(err)
+		if err != nil {
+			return nil, cmn.NewErrETL(boot.errCtx, "invalid transformer URL %q: %v", boot.uri, err)
+		}
 		revProxy := &httputil.ReverseProxy{
 			Director: func(req *http.Request) {
 				// Replacing the `req.URL` host with ETL container host
@@ -125,12 +180,42 @@ func newCommunicator(listener meta.Slistener, boot *etlBootstrapper) Communicato
 				}
 			},
 		}
+		revProxy.ErrorHandler = func(w http.ResponseWriter, req *http.Request, err error) {
+			if errors.Is(err, context.DeadlineExceeded) {
+				if timedOut, ok := req.Context().Value(rpTimedOutKey{}).(*bool); ok {
+					*timedOut = true // the caller will handle it
+					return
+				}
+			}
+			if rp.ctx.Err() != nil {
+				return // aborted - ditto
+			}
+			nlog.Errorln(rp.String(), "reverse proxy error:", err)
+			w.WriteHeader(http.StatusBadGateway)
+		}
+		revProxy.Transport = rp.client.Transport
 		rp.rp = revProxy
-		return rp
+		return rp, nil
+	case WebSocket:
+		return newWsComm(listener, boot)
 	}
 
-	debug.Assert(false, "unknown comm-type '"+boot.msg.CommTypeX+"'")
-	return nil
+	return nil, cmn.NewErrETL(boot.errCtx, "unknown comm-type %q", boot.msg.CommTypeX)
+}
+
+func (c *baseComm) init(listener meta.Slistener, boot *etlBootstrapper) {
+	c.listener, c.boot = listener, boot
+	c.client = newClient(&boot.msg.InitMsgBase)
+	c.ctx, c.abort = context.WithCancelCause(context.Background())
+}
+
+// (compare w/ core.T.DataClient() - shared by all data-path destinations)
+func newClient(msg *InitMsgBase) *http.Client {
+	return cmn.NewClient(cmn.TransportArgs{
+		IdleConnsPerHost: msg.MaxIdleConnsPerHost,
+		IdleConnTimeout:  msg.IdleConnTimeout.D(),
+		MaxConnsPerHost:  msg.MaxConnsPerHost,
+	})
 }
 
 func (c *baseComm) Name() string    { return c.boot.originalPodName }
@@ -144,37 +229,86 @@ func (c *baseComm) String() string {
 }
 
 func (c *baseComm) Xact() core.Xact { return c.boot.xctn }
+
+func (c *baseComm) objTimeout() time.Duration { return c.boot.msg.ObjTimeout.D() }
+
+func (c *baseComm) errObjTimeout(lom *core.LOM, err error) error {
+	return fmt.Errorf("%s: timed out transforming %s (obj-timeout %v): %w", c, lom.Cname(), c.objTimeout(), err)
+}
 func (c *baseComm) ObjCount() int64 { return c.boot.xctn.Objs() }
 func (c *baseComm) InBytes() int64  { return c.boot.xctn.InBytes() }
 func (c *baseComm) OutBytes() int64 { return c.boot.xctn.OutBytes() }
 
-func (c *baseComm) Stop() { c.boot.xctn.Finish() }
+func (c *baseComm) LatencyP50() time.Duration { return c.lat.percentile(50) }
+func (c *baseComm) LatencyP95() time.Duration { return c.lat.percentile(95) }
+func (c *baseComm) LatencyP99() time.Duration { return c.lat.percentile(99) }
+
+func (c *baseComm) WireOutBytes() int64 { return c.wire.out.Load() }
+func (c *baseComm) WireInBytes() int64  { return c.wire.in.Load() }
+func (c *baseComm) DryRunCount() int64  { return c.ndry.Load() }
+
+func (c *baseComm) OfflineTransformDryRun(bck *meta.Bck, objName string) (int64, error) {
+	if err := c.boot.xctn.AbortErr(); err != nil {
+		return 0, err
+	}
+	lom := core.AllocLOM(objName)
+	size, err := lomLoad(lom, bck)
+	core.FreeLOM(lom)
+	if err == nil {
+		c.ndry.Inc()
+	}
+	return size, err
+}
+
+func (c *baseComm) Stop() {
+	c.boot.xctn.Finish()
+	c.abort(fmt.Errorf("%s: %w", c, errStopped))
+	c.client.CloseIdleConnections()
+}
+
+// (compare w/ k8s readinessProbe - same endpoint)
+func (c *baseComm) HealthCheck(ctx context.Context) error {
+	u := cos.JoinPath(c.boot.uri, c.boot.healthPath())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: health check failed: %s", c, resp.Status)
+	}
+	return nil
+}
 
-func (c *baseComm) getWithTimeout(url string, size int64, timeout time.Duration) (r cos.ReadCloseSizer, err error) {
+func (c *baseComm) getWithTimeout(url string, size int64, timeout time.Duration, rng *ObjRange) (r cos.ReadCloseSizer, err error) {
 	if err := c.boot.xctn.AbortErr(); err != nil {
 		return nil, err
 	}
 
 	var (
-		req    *http.Request
-		resp   *http.Response
-		cancel func()
+		req         *http.Request
+		resp        *http.Response
+		ctx, cancel = c.reqCtx(context.Background(), timeout)
 	)
-	if timeout != 0 {
-		var ctx context.Context
-		ctx, cancel = context.WithTimeout(context.Background(), timeout)
-		req, err = http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
-	} else {
-		req, err = http.NewRequest(http.MethodGet, url, http.NoBody)
-	}
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
 	if err == nil {
-		resp, err = core.T.DataClient().Do(req) //nolint:bodyclose // Closed by the caller.
+		if rng != nil {
+			req.Header.Set(cos.HdrRange, rng.hdr())
+		}
+		started := time.Now()
+		resp, err = c.client.Do(req) //nolint:bodyclose // Closed by the caller.
+		if err == nil {
+			c.lat.add(time.Since(started))
+		}
 	}
 	if err != nil {
-		if cancel != nil {
-			cancel()
-		}
-		return nil, err
+		cancel()
+		return nil, c.abortErr(err)
 	}
 
 	return cos.NewReaderWithArgs(cos.ReaderArgs{
@@ -182,9 +316,7 @@ func (c *baseComm) getWithTimeout(url string, size int64, timeout time.Duration)
 		Size:   resp.ContentLength,
 		ReadCb: func(n int, _ error) { c.boot.xctn.InObjsAdd(0, int64(n)) },
 		DeferCb: func() {
-			if cancel != nil {
-				cancel()
-			}
+			cancel()
 			c.boot.xctn.InObjsAdd(1, 0)
 			c.boot.xctn.OutObjsAdd(1, size) // see also: `coi.objsAdd`
 		},
@@ -195,35 +327,59 @@ func (c *baseComm) getWithTimeout(url string, size int64, timeout time.Duration)
 // pushComm: implements (Hpush | HpushStdin)
 //////////////
 
-func (pc *pushComm) doRequest(bck *meta.Bck, lom *core.LOM, timeout time.Duration) (r cos.ReadCloseSizer, err error) {
+func (pc *pushComm) doRequest(bck *meta.Bck, lom *core.LOM, timeout time.Duration, rng *ObjRange) (r cos.ReadCloseSizer, err error) {
 	var ecode int
 	if err := lom.InitBck(bck.Bucket()); err != nil {
 		return nil, err
 	}
 
-	lom.Lock(false)
-	r, ecode, err = pc.do(lom, timeout)
-	lom.Unlock(false)
-
+	r, ecode, err = pc.tryDo(lom, timeout, rng)
 	if err != nil && cos.IsNotExist(err, ecode) && bck.IsRemote() {
 		_, err = core.T.GetCold(context.Background(), lom, cmn.OwtGetLock)
 		if err != nil {
 			return nil, err
 		}
+		r, _, err = pc.tryDo(lom, timeout, rng)
+	}
+	return
+}
+
+// retry transient failures, if configured (see InitMsgBase.MaxRetries);
+// every attempt re-opens the object (the request body) under rlock - not holding it in between
+func (pc *pushComm) tryDo(lom *core.LOM, timeout time.Duration, rng *ObjRange) (r cos.ReadCloseSizer, ecode int, err error) {
+	delay := pc.boot.msg.RetryDelay.D()
+	for i := 0; ; i++ {
 		lom.Lock(false)
-		r, _, err = pc.do(lom, timeout)
+		r, ecode, err = pc.do(lom, timeout, rng)
 		lom.Unlock(false)
+		if err == nil || i >= pc.boot.msg.MaxRetries || !isRetriable(err, ecode) {
+			return r, ecode, err
+		}
+		nlog.Warningln(pc.String(), "retrying", lom.Cname(), "after", delay, "err:", err, ecode)
+		select {
+		case <-time.After(delay):
+		case <-pc.ctx.Done():
+			return nil, ecode, context.Cause(pc.ctx) // (see Drain)
+		case <-pc.boot.xctn.ChanAbort():
+			return nil, ecode, pc.boot.xctn.AbortErr()
+		}
+		delay = min(delay*2, maxRetryDelay)
 	}
-	return
 }
 
-func (pc *pushComm) do(lom *core.LOM, timeout time.Duration) (_ cos.ReadCloseSizer, ecode int, err error) {
+func isRetriable(err error, ecode int) bool {
+	return cos.IsRetriableConnErr(err) || ecode >= http.StatusInternalServerError
+}
+
+func (pc *pushComm) do(lom *core.LOM, timeout time.Duration, rng *ObjRange) (_ cos.ReadCloseSizer, ecode int, err error) {
 	var (
-		body   io.ReadCloser
-		cancel func()
-		req    *http.Request
-		resp   *http.Response
-		u      string
+		body    io.ReadCloser
+		ctx     context.Context
+		cancel  func()
+		req     *http.Request
+		resp    *http.Response
+		u       string
+		started time.Time
 	)
 	if err := pc.boot.xctn.AbortErr(); err != nil {
 		return nil, 0, err
@@ -231,7 +387,19 @@ func (pc *pushComm) do(lom *core.LOM, timeout time.Duration) (_ cos.ReadCloseSiz
 	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
 		return nil, 0, err
 	}
-	size := lom.SizeBytes()
+	var (
+		off   int64
+		size  = lom.SizeBytes()
+		total = size
+	)
+	if rng != nil {
+		if pc.boot.msg.ArgTypeX == ArgTypeFQN {
+			return nil, 0, fmt.Errorf("%s: arg-type %q does not support byte range", pc, ArgTypeFQN)
+		}
+		if off, size, err = rng.resolve(total); err != nil {
+			return nil, http.StatusRequestedRangeNotSatisfiable, err
+		}
+	}
 
 	switch pc.boot.msg.ArgTypeX {
 	case ArgTypeDefault, ArgTypeURL:
@@ -241,11 +409,19 @@ func (pc *pushComm) do(lom *core.LOM, timeout time.Duration) (_ cos.ReadCloseSiz
 		debug.Assertf(lom.Bck().Ns.IsGlobal(), lom.Bck().Cname("")+" - bucket with namespace")
 		u = pc.boot.uri + "/" + lom.Bck().Name + "/" + lom.ObjName
 
-		fh, err := cos.NewFileHandle(lom.FQN)
-		if err != nil {
-			return nil, 0, err
+		if rng == nil {
+			fh, err := cos.NewFileHandle(lom.FQN)
+			if err != nil {
+				return nil, 0, err
+			}
+			body = fh
+		} else {
+			fsh, err := cos.NewFileSectionHandle(lom.FQN, off, size)
+			if err != nil {
+				return nil, 0, err
+			}
+			body = fsh
 		}
-		body = fh
 	case ArgTypeFQN:
 		body = http.NoBody
 		u = cos.JoinPath(pc.boot.uri, url.PathEscape(lom.FQN)) // compare w/ rc.redirectURL()
@@ -253,13 +429,8 @@ func (pc *pushComm) do(lom *core.LOM, timeout time.Duration) (_ cos.ReadCloseSiz
 		debug.Assert(false, "unexpected msg type:", pc.boot.msg.ArgTypeX) // is validated at construction time
 	}
 
-	if timeout != 0 {
-		var ctx context.Context
-		ctx, cancel = context.WithTimeout(context.Background(), timeout)
-		req, err = http.NewRequestWithContext(ctx, http.MethodPut, u, body)
-	} else {
-		req, err = http.NewRequest(http.MethodPut, u, body)
-	}
+	ctx, cancel = pc.reqCtx(context.Background(), timeout)
+	req, err = http.NewRequestWithContext(ctx, http.MethodPut, u, body)
 	if err != nil {
 		cos.Close(body)
 		goto finish
@@ -273,30 +444,56 @@ func (pc *pushComm) do(lom *core.LOM, timeout time.Duration) (_ cos.ReadCloseSiz
 	}
 	req.ContentLength = size
 	req.Header.Set(cos.HdrContentType, cos.ContentBinary)
+	if rng != nil {
+		req.Header.Set(cos.HdrContentRange, fmt.Sprintf("%s%d-%d/%d", cos.HdrContentRangeValPrefix, off, off+size-1, total))
+	}
+	for _, k := range pc.boot.msg.ObjMD {
+		if v, ok := lom.GetCustomKey(k); ok {
+			req.Header.Add(apc.HdrObjCustomMD, k+"="+v)
+		}
+	}
+	// (explicitly, to decompress - and account for - the response ourselves; see decompress)
+	req.Header.Set(cos.HdrAcceptEncoding, EncodingGzip+", "+EncodingDeflate)
+	if enc := pc.boot.msg.ContentEncoding; enc != "" {
+		req.Body = pc.compress(body, enc)
+		req.ContentLength = -1 // (chunked)
+		req.Header.Set(cos.HdrContentEncoding, enc)
+	}
 
 	//
 	// Do it
 	//
-	resp, err = core.T.DataClient().Do(req) //nolint:bodyclose // Closed by the caller.
+	started = time.Now()
+	resp, err = pc.client.Do(req) //nolint:bodyclose // Closed by the caller.
+	if err == nil && resp.StatusCode >= http.StatusInternalServerError {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrBodySize))
+		resp.Body.Close()
+		err = fmt.Errorf("%s: failed to transform %s: %s %q", pc, lom.Cname(), resp.Status, b)
+	}
+	if err == nil {
+		pc.lat.add(time.Since(started))
+		if enc := resp.Header.Get(cos.HdrContentEncoding); enc != "" {
+			if resp.Body, err = pc.decompress(resp.Body, enc); err != nil {
+				err = fmt.Errorf("%s: failed to decompress %s (content-encoding %q): %w", pc, lom.Cname(), enc, err)
+			}
+			resp.ContentLength = -1
+		}
+	}
 
 finish:
 	if err != nil {
-		if cancel != nil {
-			cancel()
-		}
+		cancel()
 		if resp != nil {
 			ecode = resp.StatusCode
 		}
-		return nil, ecode, err
+		return nil, ecode, pc.abortErr(err)
 	}
 	args := cos.ReaderArgs{
 		R:      resp.Body,
 		Size:   resp.ContentLength,
 		ReadCb: func(n int, _ error) { pc.boot.xctn.InObjsAdd(0, int64(n)) },
 		DeferCb: func() {
-			if cancel != nil {
-				cancel()
-			}
+			cancel()
 			pc.boot.xctn.InObjsAdd(1, 0)
 			pc.boot.xctn.OutObjsAdd(1, size) // see also: `coi.objsAdd`
 		},
@@ -304,11 +501,72 @@ finish:
 	return cos.NewReaderWithArgs(args), 0, nil
 }
 
-func (pc *pushComm) InlineTransform(w http.ResponseWriter, _ *http.Request, bck *meta.Bck, objName string) error {
+// compress the request body on the fly (see InitMsgBase.ContentEncoding);
+// when the request fails, the transport closes the pipe and the goroutine exits
+func (pc *pushComm) compress(body io.ReadCloser, enc string) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		var (
+			zw  io.WriteCloser
+			cw  = cos.NewCallbackWriter(pw, func(n int) { pc.wire.out.Add(int64(n)) })
+			err error
+		)
+		if enc == EncodingGzip {
+			zw = gzip.NewWriter(cw)
+		} else {
+			debug.Assert(enc == EncodingDeflate, enc) // validated
+			zw = zlib.NewWriter(cw)
+		}
+		_, err = io.Copy(zw, body)
+		if errC := zw.Close(); err == nil {
+			err = errC
+		}
+		cos.Close(body)
+		pw.CloseWithError(err) // nil: EOF
+	}()
+	return pr
+}
+
+// decompress the response body, if encoded by the container
+func (pc *pushComm) decompress(body io.ReadCloser, enc string) (io.ReadCloser, error) {
+	var (
+		zr  io.ReadCloser
+		err error
+		cr  = cos.NewReaderWithArgs(cos.ReaderArgs{
+			R:      body,
+			Size:   cos.ContentLengthUnknown,
+			ReadCb: func(n int, _ error) { pc.wire.in.Add(int64(n)) },
+		})
+	)
+	switch strings.ToLower(enc) {
+	case EncodingGzip, "x-gzip":
+		zr, err = gzip.NewReader(cr)
+	case EncodingDeflate:
+		zr, err = zlib.NewReader(cr)
+	case "identity":
+		return body, nil
+	default:
+		err = errors.New("not supported")
+	}
+	if err != nil {
+		body.Close()
+		return nil, err
+	}
+	return &zreader{zr, body}, nil
+}
+
+func (pc *pushComm) InlineTransform(w http.ResponseWriter, _ *http.Request, bck *meta.Bck, objName string, rng *ObjRange) error {
+	if err := pc.begin(); err != nil {
+		return err
+	}
+	defer pc.end()
 	lom := core.AllocLOM(objName)
-	r, err := pc.doRequest(bck, lom, 0 /*timeout*/)
-	core.FreeLOM(lom)
+	defer core.FreeLOM(lom)
+	r, err := pc.doRequest(bck, lom, pc.objTimeout(), rng)
 	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			err = pc.errObjTimeout(lom, err)
+		}
 		return err
 	}
 	if cmn.Rom.FastV(5, cos.SmoduleETL) {
@@ -317,34 +575,47 @@ func (pc *pushComm) InlineTransform(w http.ResponseWriter, _ *http.Request, bck
 
 	size := r.Size()
 	if size < 0 {
-		size = memsys.DefaultBufSize // TODO: track an average
+		size = pc.avgSize.Get(memsys.DefaultBufSize)
 	}
 	buf, slab := core.T.PageMM().AllocSize(size)
-	_, err = io.CopyBuffer(w, r, buf)
+	n, err := io.CopyBuffer(w, r, buf)
+	if err == nil {
+		pc.avgSize.Add(n)
+	}
 
 	slab.Free(buf)
 	r.Close()
-	return err
+	return pc.abortErr(err)
 }
 
-func (pc *pushComm) OfflineTransform(bck *meta.Bck, objName string, timeout time.Duration) (r cos.ReadCloseSizer, err error) {
+func (pc *pushComm) OfflineTransform(bck *meta.Bck, objName string, timeout time.Duration, rng *ObjRange) (r cos.ReadCloseSizer, err error) {
+	if err := pc.begin(); err != nil {
+		return nil, err
+	}
 	lom := core.AllocLOM(objName)
-	r, err = pc.doRequest(bck, lom, timeout)
+	r, err = pc.doRequest(bck, lom, timeout, rng)
 	if err == nil && cmn.Rom.FastV(5, cos.SmoduleETL) {
 		nlog.Infoln(Hpush, lom.Cname(), err)
 	}
 	core.FreeLOM(lom)
-	return
+	return pc.endOnClose(r, err)
 }
 
 //////////////////
 // redirectComm: implements Hpull
 //////////////////
 
-func (rc *redirectComm) InlineTransform(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) error {
+func (rc *redirectComm) InlineTransform(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, rng *ObjRange) error {
 	if err := rc.boot.xctn.AbortErr(); err != nil {
 		return err
 	}
+	if rng != nil {
+		return fmt.Errorf("%s: byte range is not supported (redirect)", rc)
+	}
+	if err := rc.begin(); err != nil {
+		return err
+	}
+	defer rc.end()
 
 	lom := core.AllocLOM(objName)
 	size, err := lomLoad(lom, bck)
@@ -376,79 +647,107 @@ func (rc *redirectComm) redirectURL(lom *core.LOM) string {
 	return ""
 }
 
-func (rc *redirectComm) OfflineTransform(bck *meta.Bck, objName string, timeout time.Duration) (cos.ReadCloseSizer, error) {
+func (rc *redirectComm) OfflineTransform(bck *meta.Bck, objName string, timeout time.Duration, rng *ObjRange) (cos.ReadCloseSizer, error) {
+	if err := rc.begin(); err != nil {
+		return nil, err
+	}
 	lom := core.AllocLOM(objName)
-	size, errV := lomLoad(lom, bck)
+	size, errV := lomLoadRange(lom, bck, rng)
 	if errV != nil {
 		core.FreeLOM(lom)
+		rc.end()
 		return nil, errV
 	}
 
 	etlURL := rc.redirectURL(lom)
-	r, err := rc.getWithTimeout(etlURL, size, timeout)
+	r, err := rc.getWithTimeout(etlURL, size, timeout, rng)
 
 	if cmn.Rom.FastV(5, cos.SmoduleETL) {
 		nlog.Infoln(Hpull, lom.Cname(), err)
 	}
 	core.FreeLOM(lom)
-	return r, err
+	return rc.endOnClose(r, err)
 }
 
 //////////////////
 // revProxyComm: implements Hrev
 //////////////////
 
-func (rp *revProxyComm) InlineTransform(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) error {
+func (rp *revProxyComm) InlineTransform(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, rng *ObjRange) error {
+	if err := rp.begin(); err != nil {
+		return err
+	}
+	defer rp.end()
 	lom := core.AllocLOM(objName)
-	size, err := lomLoad(lom, bck)
+	size, err := lomLoadRange(lom, bck, rng)
 	if err != nil {
 		core.FreeLOM(lom)
 		return err
 	}
+	if rng != nil {
+		r.Header.Set(cos.HdrRange, rng.hdr())
+	}
 	if size > 0 {
 		rp.boot.xctn.OutObjsAdd(1, size)
 	}
-	path := transformerPath(bck, objName)
-	core.FreeLOM(lom)
-
-	r.URL.Path, _ = url.PathUnescape(path) // `Path` must be unescaped otherwise it will be escaped again.
-	r.URL.RawPath = path                   // `RawPath` should be escaped version of `Path`.
-	rp.rp.ServeHTTP(w, r)
+	defer core.FreeLOM(lom)
 
+	r.URL.Path, r.URL.RawPath = transformerPath(bck, objName), "" // (encoded uname does not require escaping)
+	var (
+		timedOut    bool
+		started     = time.Now()
+		ctx, cancel = rp.reqCtx(context.WithValue(r.Context(), rpTimedOutKey{}, &timedOut), rp.objTimeout())
+	)
+	rp.rp.ServeHTTP(w, r.WithContext(ctx))
+	cancel()
+	if timedOut {
+		return rp.errObjTimeout(lom, context.DeadlineExceeded)
+	}
+	if rp.ctx.Err() != nil {
+		return context.Cause(rp.ctx) // aborted (see Drain)
+	}
+	rp.lat.add(time.Since(started)) // (NOTE: includes sending the response)
 	return nil
 }
 
-func (rp *revProxyComm) OfflineTransform(bck *meta.Bck, objName string, timeout time.Duration) (cos.ReadCloseSizer, error) {
+func (rp *revProxyComm) OfflineTransform(bck *meta.Bck, objName string, timeout time.Duration, rng *ObjRange) (cos.ReadCloseSizer, error) {
+	if err := rp.begin(); err != nil {
+		return nil, err
+	}
 	lom := core.AllocLOM(objName)
-	size, errV := lomLoad(lom, bck)
+	size, errV := lomLoadRange(lom, bck, rng)
 	if errV != nil {
 		core.FreeLOM(lom)
+		rp.end()
 		return nil, errV
 	}
 	etlURL := cos.JoinPath(rp.boot.uri, transformerPath(bck, objName))
-	r, err := rp.getWithTimeout(etlURL, size, timeout)
+	r, err := rp.getWithTimeout(etlURL, size, timeout, rng)
 
 	if cmn.Rom.FastV(5, cos.SmoduleETL) {
 		nlog.Infoln(Hrev, lom.Cname(), err)
 	}
 	core.FreeLOM(lom)
-	return r, err
-}
-
-//////////////
-// cbWriter //
-//////////////
-
-func (cw *cbWriter) Write(b []byte) (n int, err error) {
-	n, err = cw.w.Write(b)
-	cw.writeCb(n)
-	return
+	return rp.endOnClose(r, err)
 }
 
 //
 // utils
 //
 
+// max size of the container's error message to include
+const maxErrBodySize = 512
+
+func IsErrObjTimeout(err error) bool { return errors.Is(err, context.DeadlineExceeded) }
+
+func (zr *zreader) Close() error {
+	err := zr.ReadCloser.Close()
+	if errB := zr.body.Close(); err == nil {
+		err = errB
+	}
+	return err
+}
+
 // prune query (received from AIS proxy) prior to reverse-proxying the request to/from container -
 // not removing apc.QparamETLName, for instance, would cause infinite loop.
 func pruneQuery(rawQuery string) string {
@@ -467,20 +766,54 @@ func pruneQuery(rawQuery string) string {
 // - url.PathEscape(uname) - see below - versus
 // - Bck().Name + "/" + lom.ObjName - see pushComm above - versus
 // - bck.AddToQuery() elsewhere
+// (see also: etlParseObjectReq)
 func transformerPath(bck *meta.Bck, objName string) string {
-	return "/" + url.PathEscape(bck.MakeUname(objName))
+	return "/" + bck.EncodeUname(objName)
 }
 
+// returns cos.ErrNotFound when the object does not exist in an ais bucket;
+// (size 0, nil) - when it's a remote object that is not present (cold GET by the container)
 func lomLoad(lom *core.LOM, bck *meta.Bck) (size int64, err error) {
 	if err = lom.InitBck(bck.Bucket()); err != nil {
 		return
 	}
 	if err = lom.Load(true /*cacheIt*/, false /*locked*/); err != nil {
-		if cos.IsNotExist(err, 0) && bck.IsRemote() {
-			err = nil // NOTE: size == 0
+		if cos.IsNotExist(err, 0) {
+			if bck.IsRemote() {
+				err = nil // NOTE: size == 0
+			} else {
+				err = cos.NewErrNotFound(core.T, lom.Cname())
+			}
 		}
 	} else {
 		size = lom.SizeBytes()
 	}
 	return
 }
+
+// same as lomLoad but returns the size of the (optional) byte range
+func lomLoadRange(lom *core.LOM, bck *meta.Bck, rng *ObjRange) (size int64, err error) {
+	if size, err = lomLoad(lom, bck); err != nil || rng == nil {
+		return size, err
+	}
+	if size == 0 { // remote, not present
+		return rng.Len, nil
+	}
+	_, size, err = rng.resolve(size)
+	return size, err
+}
+
+//////////////
+// ObjRange //
+//////////////
+
+// returns offset and length clipped to the object size
+func (rng *ObjRange) resolve(size int64) (off, length int64, err error) {
+	if rng.Off < 0 || rng.Len <= 0 || rng.Off >= size {
+		err = cmn.NewErrRangeNotSatisfiable(nil, []string{rng.String()}, size)
+		return
+	}
+	return rng.Off, min(rng.Len, size-rng.Off), nil
+}
+
+func (rng *ObjRange) hdr() string { return cmn.MakeRangeHdr(rng.Off, rng.Len) }
@@ -39,6 +39,11 @@ func NewOfflineDP(msg *apc.TCBMsg, config *cmn.Config) (*OfflineDP, error) {
 	return pr, nil
 }
 
+// Dry-run (apc.TCBMsg.DryRun): estimate the transformed size without transforming.
+func (dp *OfflineDP) DryRun(lom *core.LOM) (int64, error) {
+	return dp.comm.OfflineTransformDryRun(lom.Bck(), lom.ObjName)
+}
+
 // Returns reader resulting from lom ETL transformation.
 // TODO -- FIXME: comm.OfflineTransform to support latestVer and sync
 func (dp *OfflineDP) Reader(lom *core.LOM, latestVer, sync bool) (cos.ReadOpenCloser, cos.OAH, error) {
@@ -49,7 +54,7 @@ func (dp *OfflineDP) Reader(lom *core.LOM, latestVer, sync bool) (cos.ReadOpenCl
 	)
 	debug.Assert(!latestVer && !sync, "NIY") // TODO -- FIXME
 	call := func() (int, error) {
-		r, err = dp.comm.OfflineTransform(lom.Bck(), lom.ObjName, dp.requestTimeout)
+		r, err = dp.comm.OfflineTransform(lom.Bck(), lom.ObjName, dp.requestTimeout, nil /*entire object*/)
 		return 0, err
 	}
 	// TODO: Check if ETL pod is healthy and wait some more if not (yet).
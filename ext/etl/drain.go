@@ -0,0 +1,122 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Graceful termination: upon Stop (including the one triggered by cluster membership change - see Aborter)
+// the communicator first drains - stops accepting new transforms and waits up to InitMsgBase.DrainTimeout
+// for the in-flight ones to complete. Those that remain get aborted (see reqCtx).
+// Offline transforms remain in flight until the caller closes the returned reader.
+
+const drainPoll = 10 * time.Millisecond
+
+var (
+	errDraining     = errors.New("ETL is terminating - not accepting new transforms")
+	errDrainTimeout = errors.New("ETL drain timeout exceeded")
+	errStopped      = errors.New("ETL stopped")
+)
+
+type inflightReader struct {
+	cos.ReadCloseSizer
+	c      *baseComm
+	closed atomic.Bool
+}
+
+func IsErrDraining(err error) bool { return errors.Is(err, errDraining) }
+
+func (c *baseComm) Inflight() int64 { return c.inflight.Load() }
+
+// returns errDraining when terminating; otherwise, the caller must call end()
+func (c *baseComm) begin() error {
+	c.inflight.Inc()
+	if c.draining.Load() {
+		c.end()
+		return fmt.Errorf("%s: %w", c, errDraining)
+	}
+	return nil
+}
+
+func (c *baseComm) end() { c.inflight.Dec() }
+
+// (offline) remains in flight until closed
+func (c *baseComm) endOnClose(r cos.ReadCloseSizer, err error) (cos.ReadCloseSizer, error) {
+	if err != nil {
+		c.end()
+		return nil, err
+	}
+	return &inflightReader{ReadCloseSizer: r, c: c}, nil
+}
+
+// returns nil when all in-flight transforms complete within the drain timeout;
+// otherwise, aborts those that remain and returns the (abort) error
+func (c *baseComm) Drain() error {
+	c.draining.Store(true)
+	timeout := c.boot.msg.DrainTimeout.D()
+	for elapsed := time.Duration(0); ; elapsed += drainPoll {
+		n := c.inflight.Load()
+		if n == 0 {
+			return nil
+		}
+		if elapsed >= timeout {
+			err := fmt.Errorf("%s: %w (%v) - aborting %d in-flight transform(s)", c, errDrainTimeout, timeout, n)
+			c.abort(err)
+			return err
+		}
+		time.Sleep(drainPoll)
+	}
+}
+
+// request context: canceled upon timeout (if non-zero) and when aborting in-flight transforms
+func (c *baseComm) reqCtx(parent context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+	if timeout != 0 {
+		ctx, cancel = context.WithTimeout(parent, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(parent)
+	}
+	stop := context.AfterFunc(c.ctx, cancel)
+	return ctx, func() { stop(); cancel() }
+}
+
+// when aborted, replace (context canceled) error with the abort reason
+func (c *baseComm) abortErr(err error) error {
+	if err != nil && c.ctx.Err() != nil {
+		return context.Cause(c.ctx)
+	}
+	return err
+}
+
+////////////////////
+// inflightReader //
+////////////////////
+
+func (r *inflightReader) Read(b []byte) (n int, err error) {
+	n, err = r.ReadCloseSizer.Read(b)
+	if err != nil && err != io.EOF {
+		err = r.c.abortErr(err)
+	}
+	return n, err
+}
+
+func (r *inflightReader) Close() error {
+	err := r.ReadCloseSizer.Close()
+	if r.closed.CAS(false, true) {
+		r.c.end()
+	}
+	return err
+}
@@ -0,0 +1,45 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// transform latency: percentiles over a sliding window of the most recent
+// successful transforms (see CommStats)
+
+const latWindow = 1024 // num samples
+
+type latency struct {
+	samples [latWindow]time.Duration
+	n       int64 // total recorded
+	mu      sync.Mutex
+}
+
+func (l *latency) add(d time.Duration) {
+	l.mu.Lock()
+	l.samples[l.n%latWindow] = d
+	l.n++
+	l.mu.Unlock()
+}
+
+// nearest-rank percentile; zero when there are no samples
+func (l *latency) percentile(p int) time.Duration {
+	var sorted [latWindow]time.Duration
+	l.mu.Lock()
+	num := int(min(l.n, latWindow))
+	copy(sorted[:num], l.samples[:num])
+	l.mu.Unlock()
+	if num == 0 {
+		return 0
+	}
+	s := sorted[:num]
+	slices.Sort(s)
+	rank := (p*num + 99) / 100 // ceil(p/100 * num)
+	return s[max(rank, 1)-1]
+}
@@ -69,6 +69,14 @@ func (r *registry) list() []Info {
 			ObjCount: comm.ObjCount(),
 			InBytes:  comm.InBytes(),
 			OutBytes: comm.OutBytes(),
+
+			WireOutBytes: comm.WireOutBytes(),
+			WireInBytes:  comm.WireInBytes(),
+			DryRunCount:  comm.DryRunCount(),
+
+			LatencyP50: comm.LatencyP50(),
+			LatencyP95: comm.LatencyP95(),
+			LatencyP99: comm.LatencyP99(),
 		})
 	}
 	r.mtx.RUnlock()
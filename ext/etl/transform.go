@@ -7,6 +7,7 @@ package etl
 import (
 	"context"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
 	"sync"
@@ -286,8 +287,16 @@ func start(msg *InitSpecMsg, xid string, opts StartOpts, config *cmn.Config) (er
 
 	boot.setupXaction(xid)
 
-	// finally, add Communicator to the runtime registry
-	comm := newCommunicator(newAborter(msg.IDX), boot)
+	// finally, add Communicator to the runtime registry (once healthy)
+	comm, err := newCommunicator(newAborter(msg.IDX), boot)
+	if err != nil {
+		boot.xctn.Finish()
+		return
+	}
+	if err = boot.waitHealthy(comm); err != nil {
+		boot.xctn.Finish()
+		return
+	}
 	if err = reg.add(msg.IDX, comm); err != nil {
 		return
 	}
@@ -313,6 +322,11 @@ func Stop(id string, errCause error) error {
 	errCtx.PodName = c.PodName()
 	errCtx.SvcName = c.SvcName()
 
+	// let in-flight transforms complete (or abort them) prior to terminating the container
+	if err := c.Drain(); err != nil {
+		nlog.Warningln(err)
+	}
+
 	if err := cleanupEntities(errCtx, c.PodName(), c.SvcName()); err != nil {
 		return err
 	}
@@ -367,6 +381,19 @@ func PodLogs(transformID string) (logs Logs, err error) {
 	}, nil
 }
 
+// streams (and optionally follows) the logs of the ETL's pod on this target
+func StreamPodLogs(ctx context.Context, etlName string, opts *k8s.PodLogsOpts, w io.Writer) error {
+	c, err := GetCommunicator(etlName)
+	if err != nil {
+		return err
+	}
+	client, err := k8s.GetClient()
+	if err != nil {
+		return err
+	}
+	return client.StreamPodLogs(ctx, c.PodName(), opts, w)
+}
+
 func PodHealth(etlName string) (string, error) {
 	c, err := GetCommunicator(etlName)
 	if err != nil {
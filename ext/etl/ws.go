@@ -0,0 +1,378 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/memsys"
+	"golang.org/x/net/websocket"
+)
+
+// wsComm: a single persistent WebSocket connection to the ETL container
+// that multiplexes (pipelines) object transforms as binary frames:
+//
+//	request:  [ 8-byte ID | object ]
+//	response: [ 8-byte ID | 1-byte status | transformed object (wsOK) or error message ]
+//
+// where ID (big-endian) correlates the response with its request - the container
+// is free to respond in any order.
+// Upon connection failure, all in-flight transforms fail, and the next one reconnects.
+// Objects are transformed in memory and must not exceed maxWsFrameSize
+// (small ones use memsys buffers).
+
+const (
+	wsPath = "/ws"
+
+	wsReqHdrSize  = 8
+	wsRespHdrSize = wsReqHdrSize + 1
+
+	wsOK = 0 // response status
+
+	maxWsFrameSize = 64 * cos.MiB
+
+	wsDialRetries = 4
+	wsDialDelay   = 100 * time.Millisecond // (doubles with every attempt)
+)
+
+type (
+	wsComm struct {
+		baseComm
+		config  *websocket.Config
+		conn    *websocket.Conn
+		pending map[uint64]chan wsResp
+		nextID  atomic.Uint64
+		dialing chan struct{} // closed when the (single) in-progress dial completes
+		mu      sync.Mutex    // protects conn, dialing, pending, and stopped
+		stopped bool
+	}
+	wsResp struct {
+		data []byte
+		err  error
+	}
+)
+
+// interface guard
+var _ Communicator = (*wsComm)(nil)
+
+var errWsStopped = errors.New("websocket communicator stopped")
+
+func newWsComm(listener meta.Slistener, boot *etlBootstrapper) (Communicator, error) {
+	wsURL := "ws" + strings.TrimPrefix(boot.uri, "http") + wsPath // (http => ws, https => wss)
+	config, err := websocket.NewConfig(wsURL, boot.uri /*origin*/)
+	if err != nil {
+		return nil, cmn.NewErrETL(boot.errCtx, "invalid transformer URL %q: %v", boot.uri, err)
+	}
+	wc := &wsComm{config: config, pending: make(map[uint64]chan wsResp, 64)}
+	wc.init(listener, boot)
+	return wc, nil
+}
+
+func (wc *wsComm) Stop() {
+	wc.close()
+	wc.baseComm.Stop()
+}
+
+// (in-flight transforms fail upon disconnect - see recvLoop)
+func (wc *wsComm) close() {
+	wc.mu.Lock()
+	wc.stopped = true
+	if wc.conn != nil {
+		wc.conn.Close()
+	}
+	wc.mu.Unlock()
+}
+
+func (wc *wsComm) InlineTransform(w http.ResponseWriter, _ *http.Request, bck *meta.Bck, objName string, rng *ObjRange) error {
+	if err := wc.begin(); err != nil {
+		return err
+	}
+	defer wc.end()
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	b, err := wc.doRequest(bck, lom, wc.objTimeout(), rng)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			err = wc.errObjTimeout(lom, err)
+		}
+		return err
+	}
+	if cmn.Rom.FastV(5, cos.SmoduleETL) {
+		nlog.Infoln(WebSocket, lom.Cname())
+	}
+	w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(b)))
+	_, err = w.Write(b)
+	return err
+}
+
+func (wc *wsComm) OfflineTransform(bck *meta.Bck, objName string, timeout time.Duration, rng *ObjRange) (cos.ReadCloseSizer, error) {
+	if err := wc.begin(); err != nil {
+		return nil, err
+	}
+	defer wc.end() // (transformed in memory)
+	lom := core.AllocLOM(objName)
+	b, err := wc.doRequest(bck, lom, timeout, rng)
+	if cmn.Rom.FastV(5, cos.SmoduleETL) {
+		nlog.Infoln(WebSocket, lom.Cname(), err)
+	}
+	core.FreeLOM(lom)
+	if err != nil {
+		return nil, err
+	}
+	return cos.NewByteHandle(b), nil
+}
+
+// (compare w/ pushComm.doRequest)
+func (wc *wsComm) doRequest(bck *meta.Bck, lom *core.LOM, timeout time.Duration, rng *ObjRange) ([]byte, error) {
+	if err := wc.boot.xctn.AbortErr(); err != nil {
+		return nil, err
+	}
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		return nil, err
+	}
+	frame, slab, err := wc.readObj(lom, rng)
+	if err != nil && cos.IsNotExist(err, 0) && bck.IsRemote() {
+		if _, err = core.T.GetCold(context.Background(), lom, cmn.OwtGetLock); err != nil {
+			return nil, err
+		}
+		frame, slab, err = wc.readObj(lom, rng)
+	}
+	if err != nil {
+		return nil, err
+	}
+	b, err := wc.transform(lom, frame, timeout)
+	if slab != nil {
+		slab.Free(frame)
+	}
+	return b, err
+}
+
+// returns request frame: header followed by the object's content (or its byte range);
+// the frame is allocated from memsys when it fits a page slab (non-nil slab)
+func (*wsComm) readObj(lom *core.LOM, rng *ObjRange) (frame []byte, slab *memsys.Slab, _ error) {
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return nil, nil, err
+	}
+	var (
+		off  int64
+		size = lom.SizeBytes()
+		err  error
+	)
+	if rng != nil {
+		if off, size, err = rng.resolve(size); err != nil {
+			return nil, nil, err
+		}
+	}
+	if size > maxWsFrameSize-wsRespHdrSize {
+		return nil, nil, fmt.Errorf("%s is too large to transform over websocket (size %s, max %s)",
+			lom.Cname(), cos.ToSizeIEC(size, 0), cos.ToSizeIEC(maxWsFrameSize, 0))
+	}
+	fh, err := os.Open(lom.FQN)
+	if err != nil {
+		return nil, nil, err
+	}
+	if n := wsReqHdrSize + size; n <= memsys.MaxPageSlabSize {
+		frame, slab = core.T.PageMM().AllocSize(n)
+		frame = frame[:n]
+	} else {
+		frame = make([]byte, n)
+	}
+	if _, err = io.ReadFull(io.NewSectionReader(fh, off, size), frame[wsReqHdrSize:]); err != nil && slab != nil {
+		slab.Free(frame)
+		frame, slab = nil, nil
+	}
+	cos.Close(fh)
+	return frame, slab, err
+}
+
+func (wc *wsComm) transform(lom *core.LOM, frame []byte, timeout time.Duration) ([]byte, error) {
+	var (
+		id   = wc.nextID.Inc()
+		ch   = make(chan wsResp, 1)
+		size = int64(len(frame) - wsReqHdrSize)
+	)
+	binary.BigEndian.PutUint64(frame, id)
+
+	conn, err := wc.register(id, ch, timeout)
+	if err != nil {
+		return nil, err
+	}
+	started := time.Now()
+	if err := websocket.Message.Send(conn, frame); err != nil {
+		wc.disconnect(conn, err)
+		return nil, fmt.Errorf("%s: failed to send %s: %w", wc, lom.Cname(), err)
+	}
+	wc.boot.xctn.OutObjsAdd(1, size)
+
+	var timer <-chan time.Time
+	if timeout != 0 {
+		t := time.NewTimer(timeout)
+		defer t.Stop()
+		timer = t.C
+	}
+	select {
+	case resp := <-ch:
+		if resp.err != nil {
+			return nil, fmt.Errorf("%s: failed to transform %s: %w", wc, lom.Cname(), resp.err)
+		}
+		wc.lat.add(time.Since(started))
+		wc.boot.xctn.InObjsAdd(1, int64(len(resp.data)))
+		return resp.data, nil
+	case <-timer:
+		wc.unregister(id)
+		return nil, context.DeadlineExceeded
+	case err := <-wc.boot.xctn.ChanAbort():
+		wc.unregister(id)
+		return nil, err
+	case <-wc.ctx.Done():
+		wc.unregister(id)
+		return nil, context.Cause(wc.ctx) // (see Drain)
+	}
+}
+
+// register pending transform and return the connection to send it over (connecting if need be);
+// dialing is done without holding the mutex, one dial at a time - others wait for it to complete
+func (wc *wsComm) register(id uint64, ch chan wsResp, timeout time.Duration) (*websocket.Conn, error) {
+	for {
+		wc.mu.Lock()
+		if wc.stopped {
+			wc.mu.Unlock()
+			return nil, errWsStopped
+		}
+		if conn := wc.conn; conn != nil {
+			wc.pending[id] = ch
+			wc.mu.Unlock()
+			return conn, nil
+		}
+		if dialing := wc.dialing; dialing != nil {
+			wc.mu.Unlock()
+			<-dialing
+			continue
+		}
+		dialing := make(chan struct{})
+		wc.dialing = dialing
+		wc.mu.Unlock()
+
+		if timeout == 0 {
+			timeout = wc.boot.msg.Timeout.D()
+		}
+		conn, err := wc.dial(timeout)
+
+		wc.mu.Lock()
+		wc.dialing = nil
+		close(dialing)
+		if err != nil {
+			wc.mu.Unlock()
+			return nil, err
+		}
+		if wc.stopped {
+			wc.mu.Unlock()
+			conn.Close()
+			return nil, errWsStopped
+		}
+		wc.conn = conn
+		wc.pending[id] = ch
+		wc.mu.Unlock()
+		go wc.recvLoop(conn)
+		return conn, nil
+	}
+}
+
+func (wc *wsComm) dial(timeout time.Duration) (conn *websocket.Conn, err error) {
+	ctx := context.Background()
+	if timeout != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	delay := wsDialDelay
+	for i := 0; ; i++ {
+		if conn, err = wc.config.DialContext(ctx); err == nil {
+			conn.MaxPayloadBytes = maxWsFrameSize
+			return conn, nil
+		}
+		if i >= wsDialRetries-1 || ctx.Err() != nil {
+			return nil, fmt.Errorf("%s: failed to connect: %w", wc, err)
+		}
+		nlog.Warningln(wc.String(), "failed to connect, retrying in", delay, "err:", err)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%s: failed to connect: %w", wc, ctx.Err())
+		}
+		delay *= 2
+	}
+}
+
+func (wc *wsComm) unregister(id uint64) {
+	wc.mu.Lock()
+	delete(wc.pending, id)
+	wc.mu.Unlock()
+}
+
+// receive responses and deliver them to their respective (pending) transforms
+func (wc *wsComm) recvLoop(conn *websocket.Conn) {
+	for {
+		var b []byte
+		if err := websocket.Message.Receive(conn, &b); err != nil {
+			wc.disconnect(conn, err)
+			return
+		}
+		if len(b) < wsRespHdrSize {
+			nlog.Errorln(wc.String(), "invalid response frame, size", len(b))
+			continue
+		}
+		id := binary.BigEndian.Uint64(b)
+		wc.mu.Lock()
+		ch, ok := wc.pending[id]
+		delete(wc.pending, id)
+		wc.mu.Unlock()
+		if !ok {
+			continue // timed out or aborted
+		}
+		if b[wsReqHdrSize] != wsOK {
+			ch <- wsResp{err: errors.New(string(b[wsRespHdrSize:]))}
+		} else {
+			ch <- wsResp{data: b[wsRespHdrSize:]}
+		}
+	}
+}
+
+// fail all in-flight transforms (they were sent over this connection); the next one reconnects
+func (wc *wsComm) disconnect(conn *websocket.Conn, err error) {
+	wc.mu.Lock()
+	if wc.conn != conn {
+		wc.mu.Unlock()
+		return
+	}
+	wc.conn = nil
+	conn.Close()
+	if !wc.stopped {
+		nlog.Warningln(wc.String(), "disconnected, err:", err, "- failing", len(wc.pending), "in-flight transform(s)")
+	}
+	for id, ch := range wc.pending {
+		ch <- wsResp{err: err}
+		delete(wc.pending, id)
+	}
+	wc.mu.Unlock()
+}
@@ -57,6 +57,8 @@ type (
 		flags      uint64    // bit flags (set/get atomic)
 		PathDigest uint64    // (HRW logic)
 		capacity   Capacity
+		inflight   atomic.Int64 // number of copies currently being written to this mountpath
+		pctInodes  atomic.Int32 // free inodes (%) as of the last capacity refresh
 	}
 	MPI map[string]*Mountpath
 
@@ -111,6 +113,7 @@ func NewMountpath(mpath string, label ios.Label) (*Mountpath, error) {
 		Label:      label,
 		PathDigest: xxhash.Checksum64S(cos.UnsafeB(cleanMpath), cos.MLCG32),
 	}
+	mi.pctInodes.Store(100) // (until the first capacity refresh)
 	err = mi.resolveFS()
 	return mi, err
 }
@@ -344,9 +347,40 @@ func (mi *Mountpath) getCapacity(config *cmn.Config, refresh bool) (c Capacity,
 	c.Avail = a
 	ratomic.StoreInt32(&mi.capacity.PctUsed, int32(pct))
 	c.PctUsed = int32(pct)
+
+	// (some filesystems, e.g. btrfs, allocate inodes dynamically and report zero)
+	pctInodes := int32(100)
+	if statfs.Files > 0 {
+		pctInodes = int32(uint64(statfs.Ffree) * 100 / statfs.Files)
+	}
+	mi.pctInodes.Store(pctInodes)
+	return
+}
+
+// free inodes (%) as of the last capacity refresh
+func (mi *Mountpath) PctFreeInodes() int32 { return mi.pctInodes.Load() }
+
+// cached (as of the last refresh) available/used capacity
+func (mi *Mountpath) CapCached() (c Capacity) {
+	c, _ = mi.getCapacity(nil, false /*refresh*/)
 	return
 }
 
+// in-flight copies (see core.LOM.CopyData)
+func (mi *Mountpath) IncInflight()    { mi.inflight.Inc() }
+func (mi *Mountpath) DecInflight()    { mi.inflight.Dec() }
+func (mi *Mountpath) Inflight() int64 { return mi.inflight.Load() }
+
+// used only in tests
+func (mi *Mountpath) TestSetInodes(pctFree int32) { mi.pctInodes.Store(pctFree) }
+
+// used only in tests
+func (mi *Mountpath) TestSetCapacity(c Capacity) {
+	ratomic.StoreUint64(&mi.capacity.Used, c.Used)
+	ratomic.StoreUint64(&mi.capacity.Avail, c.Avail)
+	ratomic.StoreInt32(&mi.capacity.PctUsed, c.PctUsed)
+}
+
 //
 // mountpath add/enable helpers - always call under mfs lock
 //
@@ -5,6 +5,7 @@
 package mpather
 
 import (
+	"container/heap"
 	"fmt"
 	"runtime"
 
@@ -19,7 +20,12 @@ import (
 
 type (
 	WorkerGroupOpts struct {
-		Callback  func(lom *core.LOM, buf []byte)
+		Callback func(lom *core.LOM, buf []byte)
+		// optional: when there's a backlog, process the queued objects in the order
+		// of their priority (the greater the sooner) rather than FIFO;
+		// at most QueueSize objects get prioritized at a time, and an object that has been
+		// waiting for QueueSize callbacks or more is processed next regardless of its priority
+		Priority  func(lom *core.LOM) int64
 		Slab      *memsys.Slab
 		QueueSize int
 	}
@@ -31,11 +37,23 @@ type (
 		workers map[string]*worker
 	}
 	worker struct {
-		opts   *WorkerGroupOpts
-		mi     *fs.Mountpath
-		workCh chan core.LIF
-		stopCh cos.StopCh
+		opts    *WorkerGroupOpts
+		mi      *fs.Mountpath
+		workCh  chan core.LIF
+		stopCh  cos.StopCh
+		pq      prioHeap   // (when opts.Priority != nil)
+		fifo    []*prioLOM // (ditto) same objects in the arrival order - to age them
+		npop    int64      // (ditto) number of processed prioritized objects
+		dropped int        // (ditto) number of prioritized objects dropped upon abort
 	}
+
+	prioLOM struct {
+		lom  *core.LOM
+		prio int64
+		born int64 // worker.npop at the time of arrival
+		idx  int   // heap index; -1 when processed
+	}
+	prioHeap []*prioLOM
 )
 
 func NewWorkerGroup(opts *WorkerGroupOpts) *WorkerGroup {
@@ -80,6 +98,9 @@ func (wg *WorkerGroup) Stop() (n int) {
 		n += worker.abort()
 	}
 	_ = wg.wg.Wait()
+	for _, worker := range wg.workers {
+		n += worker.dropped
+	}
 	return
 }
 
@@ -102,16 +123,22 @@ func (w *worker) work() error {
 	for {
 		select {
 		case lif := <-w.workCh:
-			lom, err := lif.LOM()
-			if err != nil {
+			lom := w.load(lif)
+			if lom == nil {
 				break
 			}
-			if err = lom.Load(false /*cache it*/, false); err == nil {
+			if w.opts.Priority == nil {
 				w.opts.Callback(lom, buf)
 			} else {
-				core.FreeLOM(lom)
+				w.push(lom)
+				w.prio(buf)
 			}
 		case <-w.stopCh.Listen(): // ABORT
+			w.dropped = len(w.pq)
+			for _, item := range w.pq {
+				core.FreeLOM(item.lom)
+			}
+			w.pq, w.fifo = nil, nil
 			close(w.workCh)
 
 			// `workCh` must be empty (if it is not, workers were not aborted correctly!)
@@ -123,6 +150,66 @@ func (w *worker) work() error {
 	}
 }
 
+func (w *worker) load(lif core.LIF) *core.LOM {
+	lom, err := lif.LOM()
+	if err != nil {
+		return nil
+	}
+	if err = lom.Load(false /*cache it*/, false); err != nil {
+		core.FreeLOM(lom)
+		return nil
+	}
+	return lom
+}
+
+func (w *worker) push(lom *core.LOM) {
+	item := &prioLOM{lom: lom, prio: w.opts.Priority(lom), born: w.npop}
+	heap.Push(&w.pq, item)
+	w.fifo = append(w.fifo, item)
+}
+
+// the highest-priority object, unless the oldest one has waited long enough
+func (w *worker) pop() *prioLOM {
+	for w.fifo[0].idx < 0 {
+		w.fifo[0] = nil
+		w.fifo = w.fifo[1:]
+	}
+	var item *prioLOM
+	if oldest := w.fifo[0]; w.npop-oldest.born >= int64(w.opts.QueueSize) {
+		item = heap.Remove(&w.pq, oldest.idx).(*prioLOM)
+	} else {
+		item = heap.Pop(&w.pq).(*prioLOM)
+	}
+	w.npop++
+	return item
+}
+
+// prioritized processing: keep moving queued work into the heap (up to QueueSize,
+// leaving the rest in the channel - to apply backpressure via PostLIF)
+// and execute the callback on the next object, one at a time
+func (w *worker) prio(buf []byte) {
+	for len(w.pq) > 0 {
+	pull:
+		for len(w.pq) < w.opts.QueueSize {
+			select {
+			case lif := <-w.workCh:
+				if lom := w.load(lif); lom != nil {
+					w.push(lom)
+				}
+			default:
+				break pull
+			}
+		}
+		select {
+		case <-w.stopCh.Listen():
+			return // (see work() above)
+		default:
+		}
+		item := w.pop()
+		w.opts.Callback(item.lom, buf)
+	}
+}
+
 func (w *worker) abort() int {
 	n := drainWorkCh(w.workCh)
 	w.stopCh.Close()
@@ -141,3 +228,30 @@ func drainWorkCh(workCh chan core.LIF) (n int) {
 		}
 	}
 }
+
+//////////////
+// prioHeap //
+//////////////
+
+func (h prioHeap) Len() int           { return len(h) }
+func (h prioHeap) Less(i, j int) bool { return h[i].prio > h[j].prio }
+func (h prioHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].idx, h[j].idx = i, j
+}
+
+func (h *prioHeap) Push(x any) {
+	item := x.(*prioLOM)
+	item.idx = len(*h)
+	*h = append(*h, item)
+}
+
+func (h *prioHeap) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	item.idx = -1
+	*h = old[0 : n-1]
+	return item
+}
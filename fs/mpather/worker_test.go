@@ -6,6 +6,7 @@ package mpather_test
 
 import (
 	"os"
+	"sync"
 	"testing"
 	"time"
 
@@ -59,3 +60,108 @@ func TestWorkerGroup(t *testing.T) {
 		"invalid number of objects visited (%d vs %d)", counter.Load(), len(out.FQNs[fs.ObjectType]),
 	)
 }
+
+func TestWorkerGroupPriority(t *testing.T) {
+	var (
+		desc = tools.ObjectsDesc{
+			CTs: []tools.ContentTypeDesc{
+				{Type: fs.ObjectType, ContentCnt: 20},
+			},
+			MountpathsCnt: 1,
+			ObjectSize:    cos.KiB,
+		}
+		out   = tools.PrepareObjects(t, desc)
+		fqns  = out.FQNs[fs.ObjectType]
+		prios = make(map[string]int64, len(fqns))
+		mu    sync.Mutex
+		order []int64
+	)
+	defer os.RemoveAll(out.Dir)
+
+	wg := mpather.NewWorkerGroup(&mpather.WorkerGroupOpts{
+		Callback: func(lom *core.LOM, _ []byte) {
+			mu.Lock()
+			order = append(order, prios[lom.ObjName])
+			mu.Unlock()
+		},
+		Priority:  func(lom *core.LOM) int64 { return prios[lom.ObjName] },
+		QueueSize: len(fqns),
+	})
+	defer wg.Stop()
+
+	// queue everything upfront (i.e., backlog) and only then start
+	for i, fqn := range fqns {
+		lom := &core.LOM{}
+		err := lom.InitFQN(fqn, &out.Bck)
+		tassert.CheckFatal(t, err)
+		prios[lom.ObjName] = int64((i * 7) % len(fqns))
+
+		_, err = wg.PostLIF(lom)
+		tassert.CheckError(t, err)
+	}
+	wg.Run()
+
+	time.Sleep(time.Second)
+
+	mu.Lock()
+	defer mu.Unlock()
+	tassert.Fatalf(t, len(order) == len(fqns), "invalid number of objects visited (%d vs %d)", len(order), len(fqns))
+	for i := 1; i < len(order); i++ {
+		tassert.Errorf(t, order[i-1] >= order[i], "expecting descending priority, got %v", order)
+	}
+}
+
+func TestWorkerGroupPriorityAging(t *testing.T) {
+	const queueSize = 4
+	var (
+		desc = tools.ObjectsDesc{
+			CTs: []tools.ContentTypeDesc{
+				{Type: fs.ObjectType, ContentCnt: 40},
+			},
+			MountpathsCnt: 1,
+			ObjectSize:    cos.KiB,
+		}
+		out   = tools.PrepareObjects(t, desc)
+		fqns  = out.FQNs[fs.ObjectType]
+		prios = make(map[string]int64, len(fqns))
+		mu    sync.Mutex
+		order []int64
+	)
+	defer os.RemoveAll(out.Dir)
+
+	loms := make([]*core.LOM, 0, len(fqns))
+	for i, fqn := range fqns {
+		lom := &core.LOM{}
+		err := lom.InitFQN(fqn, &out.Bck)
+		tassert.CheckFatal(t, err)
+		prios[lom.ObjName] = int64(i) // every new arrival is "hotter" than all the queued ones
+		loms = append(loms, lom)
+	}
+
+	wg := mpather.NewWorkerGroup(&mpather.WorkerGroupOpts{
+		Callback: func(lom *core.LOM, _ []byte) {
+			time.Sleep(time.Millisecond)
+			mu.Lock()
+			order = append(order, prios[lom.ObjName])
+			mu.Unlock()
+		},
+		Priority:  func(lom *core.LOM) int64 { return prios[lom.ObjName] },
+		QueueSize: queueSize,
+	})
+	defer wg.Stop()
+	wg.Run()
+
+	for _, lom := range loms {
+		_, err := wg.PostLIF(lom)
+		tassert.CheckError(t, err)
+	}
+	time.Sleep(time.Second)
+
+	mu.Lock()
+	defer mu.Unlock()
+	tassert.Fatalf(t, len(order) == len(fqns), "invalid number of objects visited (%d vs %d)", len(order), len(fqns))
+	// no starvation: each object gets processed within (heap + aging) bound of its arrival
+	for pos, prio := range order {
+		tassert.Errorf(t, int64(pos) <= prio+2*queueSize, "object #%d processed too late (at %d): %v", prio, pos, order)
+	}
+}
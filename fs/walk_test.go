@@ -10,7 +10,9 @@ import (
 	"path/filepath"
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -193,3 +195,47 @@ func TestWalkBckSkipDir(t *testing.T) {
 	}
 	tassert.Fatalf(t, expectedTotal == len(fqns), "expected %d objects, got %d", expectedTotal, len(fqns))
 }
+
+func TestRmOldWorkfiles(t *testing.T) {
+	bck := cmn.Bck{Name: "name", Provider: apc.AIS}
+	fs.TestNew(mock.NewIOS())
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+
+	mpath, err := os.MkdirTemp("", "testwkgc")
+	tassert.CheckFatal(t, err)
+	defer os.RemoveAll(mpath)
+	_, err = fs.Add(mpath, "daeID")
+	tassert.CheckFatal(t, err)
+
+	avail, _ := fs.Get()
+	mi := avail[mpath]
+	tassert.CheckFatal(t, cos.CreateDir(mi.MakePathCT(&bck, fs.WorkfileType)))
+
+	// workfiles: current (running) process, previous process, previous process (recent)
+	var (
+		wk  = fs.CSM.Resolver(fs.WorkfileType)
+		gen = func(name string, prev bool) string {
+			fqn := mi.MakePathFQN(&bck, fs.WorkfileType, wk.GenUniqueFQN(name, fs.WorkfilePut))
+			if prev {
+				fqn = fqn[:strings.LastIndexByte(fqn, '.')] + ".1" // (pid)
+			}
+			return fqn
+		}
+		cur   = gen("cur", false)
+		old   = gen("old", true)
+		fresh = gen("recent", true)
+		past  = time.Now().Add(-2 * time.Hour)
+	)
+	for _, fqn := range []string{cur, old, fresh} {
+		tassert.CheckFatal(t, os.WriteFile(fqn, []byte("0123456789"), cos.PermRWR))
+	}
+	tassert.CheckFatal(t, os.Chtimes(cur, past, past))
+	tassert.CheckFatal(t, os.Chtimes(old, past, past))
+
+	n, size := fs.RmOldWorkfiles([]cmn.Bck{bck}, time.Hour)
+	tassert.Errorf(t, n == 1 && size == 10, "expected to remove 1 workfile (10 bytes), got (%d, %d)", n, size)
+	tassert.Errorf(t, cos.Stat(old) != nil, "abandoned workfile %q must be removed", old)
+	tassert.Errorf(t, cos.Stat(cur) == nil, "workfile %q of the running process must be kept", cur)
+	tassert.Errorf(t, cos.Stat(fresh) == nil, "recently modified workfile %q must be kept", fresh)
+}
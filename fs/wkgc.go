@@ -0,0 +1,91 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// startup GC of abandoned workfiles (see also: space cleanup)
+
+const DfltWorkfileTTL = time.Hour
+
+type wkgc struct {
+	mi     *Mountpath
+	before int64 // unix nano
+	n      atomic.Int64
+	size   atomic.Int64
+}
+
+// Remove workfiles (PUT, cold GET, S3 multipart parts, copy/mirror temp files, etc.)
+// that were created by a previous (crashed or restarted) aisnode process and haven't
+// been modified for at least `ttl`. Since operations do not survive restarts, the
+// corresponding uploads and xactions no longer exist and the workfiles are garbage.
+// Returns the number of removed files and their total size.
+func RmOldWorkfiles(bcks []cmn.Bck, ttl time.Duration) (n, size int64) {
+	if ttl <= 0 {
+		ttl = DfltWorkfileTTL
+	}
+	var (
+		wg     sync.WaitGroup
+		avail  = GetAvail()
+		before = time.Now().Add(-ttl).UnixNano()
+		jogs   = make([]*wkgc, 0, len(avail))
+	)
+	for _, mi := range avail {
+		j := &wkgc{mi: mi, before: before}
+		jogs = append(jogs, j)
+		wg.Add(1)
+		go j.run(bcks, &wg)
+	}
+	wg.Wait()
+	for _, j := range jogs {
+		n += j.n.Load()
+		size += j.size.Load()
+	}
+	if n > 0 {
+		nlog.Infoln("removed", n, "abandoned workfile(s), reclaimed", cos.ToSizeIEC(size, 2))
+	}
+	return n, size
+}
+
+func (j *wkgc) run(bcks []cmn.Bck, wg *sync.WaitGroup) {
+	defer wg.Done()
+	for i := range bcks {
+		opts := &WalkOpts{Mi: j.mi, Bck: bcks[i], CTs: []string{WorkfileType}, Callback: j.visit}
+		if err := Walk(opts); err != nil {
+			nlog.Warningln(j.mi.String()+":", "failed to GC workfiles of", bcks[i].Cname(""), "err:", err)
+		}
+	}
+}
+
+func (j *wkgc) visit(fqn string, de DirEntry) error {
+	if de.IsDir() {
+		return nil
+	}
+	_, old, ok := CSM.Resolver(WorkfileType).ParseUniqueFQN(filepath.Base(fqn))
+	if !ok || !old {
+		return nil // not ours or belongs to this (running) process
+	}
+	finfo, err := os.Lstat(fqn)
+	if err != nil || finfo.ModTime().UnixNano() > j.before {
+		return nil
+	}
+	if err := cos.RemoveFile(fqn); err != nil {
+		nlog.Errorln("failed to remove abandoned workfile", fqn, "err:", err)
+		return nil
+	}
+	j.n.Inc()
+	j.size.Add(finfo.Size())
+	return nil
+}
@@ -6,6 +6,12 @@
 package ios
 
 type (
-	DiskStats    struct{ RBps, Ravg, WBps, Wavg, Util int64 }
+	DiskStats struct {
+		RBps, Ravg, WBps, Wavg, Util int64
+		// saturation, e.g. for fast (NVMe) drives where utilization is a poor signal;
+		// zero when not available (see blockStats)
+		Rlat, Wlat int64   // average read and write latency (nanoseconds)
+		Aqu        float64 // average queue size, same as iostat's "aqu-sz"
+	}
 	AllDiskStats map[string]DiskStats
 )
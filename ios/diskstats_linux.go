@@ -91,13 +91,14 @@ func _exI64(field string) int64 {
 	return val
 }
 
-func (ds *blockStats) Reads() int64      { return ds.readComplete }
-func (ds *blockStats) ReadBytes() int64  { return ds.readSectors * sectorSize }
-func (ds *blockStats) Writes() int64     { return ds.writeComplete }
-func (ds *blockStats) WriteBytes() int64 { return ds.writeSectors * sectorSize }
-func (ds *blockStats) IOMs() int64       { return ds.ioMs }
-func (ds *blockStats) WriteMs() int64    { return ds.writeMs }
-func (ds *blockStats) ReadMs() int64     { return ds.readMs }
+func (ds *blockStats) Reads() int64        { return ds.readComplete }
+func (ds *blockStats) ReadBytes() int64    { return ds.readSectors * sectorSize }
+func (ds *blockStats) Writes() int64       { return ds.writeComplete }
+func (ds *blockStats) WriteBytes() int64   { return ds.writeSectors * sectorSize }
+func (ds *blockStats) IOMs() int64         { return ds.ioMs }
+func (ds *blockStats) WriteMs() int64      { return ds.writeMs }
+func (ds *blockStats) ReadMs() int64       { return ds.readMs }
+func (ds *blockStats) IOMsWeighted() int64 { return ds.ioMsWeighted }
 
 // NVMe multipathing
 // * nvmeInN:     instance I namespace N
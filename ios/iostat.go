@@ -51,6 +51,10 @@ type (
 		writes map[string]int64 // completed write requests
 		wbps   map[string]int64 // write B/s
 		wavg   map[string]int64 // average write size
+		iomsw  map[string]int64 // weighted IO millis (queue size x time)
+		rlat   map[string]int64 // average read latency
+		wlat   map[string]int64 // average write latency
+		aqu    map[string]float64
 
 		mpathUtil   map[string]int64 // Average utilization of the disks, range [0, 100].
 		mpathUtilRO MpathUtil        // Read-only copy of `mpathUtil`.
@@ -136,6 +140,10 @@ func newCache(num int) *cache {
 		writes:    make(map[string]int64, num),
 		wbps:      make(map[string]int64, num),
 		wavg:      make(map[string]int64, num),
+		iomsw:     make(map[string]int64, num),
+		rlat:      make(map[string]int64, num),
+		wlat:      make(map[string]int64, num),
+		aqu:       make(map[string]float64, num),
 		mpathUtil: make(map[string]int64, num),
 	}
 }
@@ -318,6 +326,9 @@ func (ios *ios) FillDiskStats(m AllDiskStats) {
 			WBps: cache.wbps[disk],
 			Wavg: cache.wavg[disk],
 			Util: cache.util[disk],
+			Rlat: cache.rlat[disk],
+			Wlat: cache.wlat[disk],
+			Aqu:  cache.aqu[disk],
 		}
 	}
 	for disk := range m {
@@ -401,6 +412,9 @@ func (ios *ios) _ref(config *cmn.Config) (ncache *cache, maxUtil int64, missingI
 		ncache.util[disk] = 0
 		ncache.ravg[disk] = 0
 		ncache.wavg[disk] = 0
+		ncache.rlat[disk] = 0
+		ncache.wlat[disk] = 0
+		ncache.aqu[disk] = 0
 		ds := ios.blockStats[disk]
 		ncache.ioms[disk] = ds.IOMs()
 		ncache.rms[disk] = ds.ReadMs()
@@ -409,6 +423,7 @@ func (ios *ios) _ref(config *cmn.Config) (ncache *cache, maxUtil int64, missingI
 		ncache.wms[disk] = ds.WriteMs()
 		ncache.wbytes[disk] = ds.WriteBytes()
 		ncache.writes[disk] = ds.Writes()
+		ncache.iomsw[disk] = ds.IOMsWeighted()
 
 		if _, ok := statsCache.ioms[disk]; !ok {
 			missingInfo = true
@@ -421,6 +436,9 @@ func (ios *ios) _ref(config *cmn.Config) (ncache *cache, maxUtil int64, missingI
 			writes     = ncache.writes[disk] - statsCache.writes[disk]
 			readBytes  = ncache.rbytes[disk] - statsCache.rbytes[disk]
 			writeBytes = ncache.wbytes[disk] - statsCache.wbytes[disk]
+			rms        = ncache.rms[disk] - statsCache.rms[disk]
+			wms        = ncache.wms[disk] - statsCache.wms[disk]
+			iomsw      = ncache.iomsw[disk] - statsCache.iomsw[disk]
 		)
 		if elapsedMillis > 0 {
 			// On macOS computation of `diskUtil` may sometimes exceed 100%
@@ -457,6 +475,16 @@ func (ios *ios) _ref(config *cmn.Config) (ncache *cache, maxUtil int64, missingI
 		} else {
 			ncache.wavg[disk] = 0
 		}
+		// latencies and queue size (zero if not supported)
+		if reads > 0 {
+			ncache.rlat[disk] = cos.DivRound(rms*int64(time.Millisecond), reads)
+		}
+		if writes > 0 {
+			ncache.wlat[disk] = cos.DivRound(wms*int64(time.Millisecond), writes)
+		}
+		if elapsedMillis > 0 && iomsw > 0 {
+			ncache.aqu[disk] = float64(iomsw) / float64(elapsedMillis)
+		}
 	}
 
 	// average and max
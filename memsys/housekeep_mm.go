@@ -80,6 +80,7 @@ func (r *MMSA) hkcb() time.Duration {
 	debug.AssertNoErr(err)
 	r.updSwap(&r.mem)
 	pressure := r.Pressure(&r.mem)
+	r.notifyPressure(pressure)
 
 	// 3. memory is enough, free only those that are idle for a while
 	if pressure == PressureLow {
@@ -148,6 +148,7 @@ type (
 		defBufSize    int64
 		mem           sys.MemStat
 		numSlabs      int
+		psubs         pressureSubs // (see RegPressureCB)
 		// atomic state
 		toGC     atomic.Int64 // accumulates over time and triggers GC upon reaching spec-ed limit
 		optDepth atomic.Int64 // ring "depth", i.e., num free bufs we trend to (see grow())
@@ -7,6 +7,7 @@ package memsys
 
 import (
 	"strconv"
+	"sync"
 
 	"github.com/NVIDIA/aistore/sys"
 )
@@ -23,6 +24,18 @@ const (
 
 const highLowThreshold = 40
 
+type (
+	// to get notified when memory pressure changes (see RegPressureCB);
+	// called from the housekeeping goroutine - must not block
+	PressureCB func(pressure int)
+
+	pressureSubs struct {
+		m    map[string]PressureCB
+		mu   sync.Mutex
+		prev int
+	}
+)
+
 var memPressureText = map[int]string{
 	PressureLow:      "low",
 	PressureModerate: "moderate",
@@ -95,3 +108,47 @@ func (r *MMSA) pressure2S(p int) (sp string) {
 	}
 	return sp
 }
+
+func PressureText(p int) string { return memPressureText[p] }
+
+//
+// pressure subscriptions: running xactions (and others) can reduce their concurrency
+// and/or buffer sizes when the pressure rises
+//
+
+// (the callback gets immediately called with the last known pressure)
+func (r *MMSA) RegPressureCB(name string, cb PressureCB) {
+	r.psubs.mu.Lock()
+	if r.psubs.m == nil {
+		r.psubs.m = make(map[string]PressureCB, 4)
+	}
+	r.psubs.m[name] = cb
+	prev := r.psubs.prev
+	r.psubs.mu.Unlock()
+	cb(prev)
+}
+
+func (r *MMSA) UnregPressureCB(name string) {
+	r.psubs.mu.Lock()
+	delete(r.psubs.m, name)
+	r.psubs.mu.Unlock()
+}
+
+// notify subscribers upon change
+func (r *MMSA) notifyPressure(pressure int) {
+	r.psubs.mu.Lock()
+	if pressure == r.psubs.prev || len(r.psubs.m) == 0 {
+		r.psubs.prev = pressure
+		r.psubs.mu.Unlock()
+		return
+	}
+	r.psubs.prev = pressure
+	cbs := make([]PressureCB, 0, len(r.psubs.m))
+	for _, cb := range r.psubs.m {
+		cbs = append(cbs, cb)
+	}
+	r.psubs.mu.Unlock()
+	for _, cb := range cbs {
+		cb(pressure)
+	}
+}
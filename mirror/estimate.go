@@ -0,0 +1,67 @@
+// Package mirror provides local mirroring and replica management
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package mirror
+
+import (
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+type (
+	// EstimateCopies result: additional capacity required to bring a bucket
+	// to a given number of copies
+	MirrorEstimate struct {
+		Mpaths map[string]*MpathEstimate `json:"mpaths"`        // per mountpath
+		Objs   int64                     `json:"objs,string"`   // number of objects that need (more) copies
+		Size   int64                     `json:"size,string"`   // total additional bytes
+		Insuff int64                     `json:"insuff,string"` // objects that would end up with fewer copies (errInsuffMpaths)
+		Fits   bool                      `json:"fits"`          // all mountpaths have enough free space
+	}
+	MpathEstimate struct {
+		Size  int64  `json:"size,string"`  // additional bytes
+		Avail uint64 `json:"avail,string"` // currently available
+		Fits  bool   `json:"fits"`         // Size < Avail
+	}
+)
+
+// EstimateCopies is a mirroring dry-run: walks a given bucket and, for each object
+// with fewer than the specified number of copies, selects destination mountpaths
+// via the same placement logic that mirroring uses (see core.LOM.LeastUtilNoCopyN);
+// writes nothing but keeps track of the simulated per-mountpath usage, so that
+// each placement takes into account the copies planned for the preceding objects
+// (see core.LOM.LeastUtilNoCopyPlan)
+func EstimateCopies(bck *meta.Bck, copies int) (est *MirrorEstimate, err error) {
+	plan := make(core.CopyPlan, 4)
+	est = &MirrorEstimate{Mpaths: make(map[string]*MpathEstimate, 4)}
+	err = walkMains(bck, func(lom *core.LOM) {
+		n := copies - lom.NumCopies()
+		if n <= 0 {
+			return
+		}
+		mis := lom.LeastUtilNoCopyPlan(n, plan)
+		if len(mis) < n {
+			est.Insuff++
+		}
+		if len(mis) == 0 {
+			return
+		}
+		est.Objs++
+		for _, mi := range mis {
+			e, ok := est.Mpaths[mi.Path]
+			if !ok {
+				e = &MpathEstimate{Avail: mi.CapCached().Avail}
+				est.Mpaths[mi.Path] = e
+			}
+			e.Size += lom.SizeBytes()
+			est.Size += lom.SizeBytes()
+		}
+	})
+	est.Fits = true
+	for _, e := range est.Mpaths {
+		e.Fits = uint64(e.Size) < e.Avail
+		est.Fits = est.Fits && e.Fits
+	}
+	return
+}
@@ -0,0 +1,135 @@
+// Package mirror provides local mirroring and replica management
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package mirror
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// replica-created events (for audit and observability): counted in target stats
+// (regardless of the callback) and, in addition, batched and delivered
+// to the registered callback when the batch is full or, otherwise, at least
+// every so often (see below) - not to flood the receiver under heavy mirroring.
+// Delivery is asynchronous: batches are queued (bounded) and the callback runs
+// in its own goroutine - never on the copying worker; when the receiver falls
+// behind and the queue is full the batch gets dropped (and counted)
+
+const (
+	evBatchSize = 256
+	evFlushTime = 10 * time.Second
+	evQueueSize = 16 // max pending batches
+)
+
+type (
+	ReplicaEvent struct {
+		Bck     cmn.Bck `json:"bck"`
+		ObjName string  `json:"name"`
+		Src     string  `json:"src"` // source mountpath
+		Dst     string  `json:"dst"` // destination mountpath (of the new replica)
+		Size    int64   `json:"size,string"`
+		Time    int64   `json:"time,string"` // unix nano
+	}
+	ReplicaCB func(events []ReplicaEvent)
+
+	evBatcher struct {
+		tstats  cos.StatsUpdater // nil in tests
+		cb      ReplicaCB
+		workCh  chan []ReplicaEvent // nil when there's no callback
+		batch   []ReplicaEvent
+		posted  int64 // mono time of the last delivery
+		dropped atomic.Int64
+		mu      sync.Mutex
+	}
+)
+
+var replEvents evBatcher
+
+// register (or unregister, if nil) the (external) callback to receive replica-created events;
+// the previous callback (if any) receives pending events and exits
+func RegReplicaCB(cb ReplicaCB) {
+	b := &replEvents
+	b.mu.Lock()
+	if len(b.batch) > 0 && b.cb != nil {
+		b._send()
+	}
+	if b.workCh != nil {
+		close(b.workCh)
+		b.workCh = nil
+	}
+	b.cb, b.posted = cb, mono.NanoTime()
+	if cb != nil {
+		b.workCh = make(chan []ReplicaEvent, evQueueSize)
+		go deliver(cb, b.workCh)
+	}
+	b.mu.Unlock()
+}
+
+func deliver(cb ReplicaCB, workCh <-chan []ReplicaEvent) {
+	for batch := range workCh {
+		cb(batch)
+	}
+}
+
+func (b *evBatcher) add(lom *core.LOM, dst *fs.Mountpath) {
+	if b.tstats != nil {
+		b.tstats.AddMany(
+			cos.NamedVal64{Name: stats.ReplicaCreatedCount, Value: 1},
+			cos.NamedVal64{Name: stats.ReplicaCreatedSize, Value: lom.SizeBytes()},
+		)
+	}
+	b.mu.Lock()
+	if b.cb == nil {
+		b.mu.Unlock()
+		return
+	}
+	b.batch = append(b.batch, ReplicaEvent{
+		Bck:     *lom.Bucket(),
+		ObjName: lom.ObjName,
+		Src:     lom.Mountpath().Path,
+		Dst:     dst.Path,
+		Size:    lom.SizeBytes(),
+		Time:    time.Now().UnixNano(),
+	})
+	if len(b.batch) >= evBatchSize || mono.Since(b.posted) >= evFlushTime {
+		b._send()
+	}
+	b.mu.Unlock()
+}
+
+// deliver pending events, if any (e.g., upon xaction's completion)
+func (b *evBatcher) flush() {
+	b.mu.Lock()
+	if len(b.batch) > 0 && b.cb != nil {
+		b._send()
+	}
+	b.mu.Unlock()
+}
+
+// under lock; never blocks
+func (b *evBatcher) _send() {
+	batch := b.batch
+	b.batch, b.posted = make([]ReplicaEvent, 0, len(batch)), mono.NanoTime()
+	select {
+	case b.workCh <- batch:
+	default:
+		n := b.dropped.Add(int64(len(batch)))
+		if b.tstats != nil {
+			b.tstats.Add(stats.ReplicaEvDropCount, int64(len(batch)))
+		}
+		if n == int64(len(batch)) { // first time
+			nlog.Warningln("replica-created events: receiver falls behind, dropping", len(batch), "events")
+		}
+	}
+}
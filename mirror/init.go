@@ -5,10 +5,14 @@
 package mirror
 
 import (
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/xact/xreg"
 )
 
-func Init() {
+func Init(tstats cos.StatsUpdater) {
 	xreg.RegBckXact(&mncFactory{})
 	xreg.RegBckXact(&putFactory{})
+
+	// count created replicas (target stats); the callback remains available (see RegReplicaCB)
+	replEvents.tstats = tstats
 }
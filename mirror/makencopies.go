@@ -5,11 +5,13 @@
 package mirror
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
@@ -35,6 +37,8 @@ type (
 		p *mncFactory
 		xact.BckJog
 		_nam, _str string
+		insuff     atomic.Int64 // number of objects with fewer than the requested copies (see errInsuffMpaths)
+		stats      copyStats
 	}
 )
 
@@ -107,6 +111,14 @@ func (r *mncXact) Run(wg *sync.WaitGroup) {
 	if err != nil {
 		r.AddErr(err)
 	}
+	replEvents.flush()
+	if n := r.stats.repaired.Load(); n > 0 {
+		nlog.Infof("%s: repaired %d object%s (restored missing copies)", r.Name(), n, cos.Plural(int(n)))
+	}
+	if n := r.insuff.Load(); n > 0 {
+		nlog.Warningf("%s: %d object%s ended up with fewer than %d copies (%v)", r.Name(), n, cos.Plural(int(n)),
+			r.p.args.Copies, errInsuffMpaths)
+	}
 	r.Finish()
 }
 
@@ -115,7 +127,23 @@ func (r *mncXact) visitObj(lom *core.LOM, buf []byte) (err error) {
 		size   int64
 		n      = lom.NumCopies()
 		copies = r.p.args.Copies
+		repair bool
 	)
+	// repair: first, forget recorded copies that are gone (e.g., lost with a failed disk)
+	if r.p.args.Repair && n > 1 {
+		var nmiss int
+		lom.Lock(true)
+		nmiss, err = delMissingCopies(lom)
+		lom.Unlock(true)
+		if err != nil {
+			if cos.IsNotExist(err, 0) {
+				return nil
+			}
+			r.AddErr(err, 5, cos.SmoduleMirror)
+			return nil
+		}
+		n, repair = lom.NumCopies(), nmiss > 0
+	}
 	switch {
 	case n == copies:
 		return nil
@@ -124,15 +152,20 @@ func (r *mncXact) visitObj(lom *core.LOM, buf []byte) (err error) {
 		size, err = delCopies(lom, copies)
 		lom.Unlock(true)
 	default:
-		lom.Lock(true)
-		size, err = addCopies(lom, copies, buf)
-		lom.Unlock(true)
+		size, err = lockAddCopies(lom, copies, buf, &r.stats)
 	}
 
 	if err != nil {
 		if cos.IsNotExist(err, 0) {
 			return nil
 		}
+		if errors.Is(err, errInsuffMpaths) {
+			// keep going with the copies made so far
+			r.insuff.Inc()
+			r.AddErr(err, 5, cos.SmoduleMirror)
+			r.ObjsAdd(1, size)
+			return nil
+		}
 		if cos.IsErrOOS(err) {
 			r.Abort(err)
 		} else {
@@ -149,6 +182,9 @@ func (r *mncXact) visitObj(lom *core.LOM, buf []byte) (err error) {
 	if cmn.Rom.FastV(5, cos.SmoduleMirror) {
 		nlog.Infof("%s: %s, copies %d=>%d, size=%d", r.Base.Name(), lom.Cname(), n, copies, size)
 	}
+	if repair && n < copies {
+		r.stats.repaired.Inc()
+	}
 	r.ObjsAdd(1, size)
 	if cnt := r.Objs(); cnt%128 == 0 { // TODO: configurable
 		cs := fs.Cap()
@@ -166,6 +202,9 @@ func (r *mncXact) Snap() (snap *core.Snap) {
 	snap = &core.Snap{}
 	r.ToSnap(snap)
 
+	ext := r.stats.ext()
+	ext.Throughput = r.stats.tput(snap.StartTime)
+	snap.Ext = ext
 	snap.IdleX = r.IsIdle()
 	return
 }
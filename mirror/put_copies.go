@@ -25,6 +25,12 @@ import (
 	"github.com/NVIDIA/aistore/xact/xreg"
 )
 
+// (see XactPut.throttle)
+const (
+	throttleMinSleep = 10 * time.Millisecond
+	throttleMaxWait  = 4 * time.Second
+)
+
 type (
 	putFactory struct {
 		xreg.RenewBase
@@ -35,12 +41,18 @@ type (
 		// implements core.Xact interface
 		xact.DemandBase
 		// runtime
-		workers  *mpather.WorkerGroup
-		workCh   chan core.LIF
-		chanFull atomic.Int64
+		workers   *mpather.WorkerGroup
+		workCh    chan core.LIF
+		batches   map[string]*int // per-mountpath count of objects since the last pause (see mirror.batch_size)
+		chanFull  atomic.Int64
+		skipped   atomic.Int64  // number of objects not mirrored as per the bucket's (updated) props
+		throttled atomic.Int64  // number of times backed off under high foreground load (see throttle)
+		mempr     atomic.Int32  // current memory pressure (see memsys.RegPressureCB)
+		memsema   chan struct{} // to serialize workers under high memory pressure
 		// init
 		mirror cmn.MirrorConf
 		config *cmn.Config
+		stats  copyStats
 	}
 )
 
@@ -72,7 +84,12 @@ func (p *putFactory) Start() error {
 		nlog.Errorln(err)
 		return err
 	}
-	r := &XactPut{mirror: *mirror, workCh: make(chan core.LIF, mirror.Burst)}
+	r := &XactPut{mirror: *mirror, workCh: make(chan core.LIF, mirror.Burst), memsema: make(chan struct{}, 1)}
+	avail := fs.GetAvail()
+	r.batches = make(map[string]*int, len(avail))
+	for mpath := range avail {
+		r.batches[mpath] = new(int)
+	}
 
 	//
 	// target-local generation of a global UUID
@@ -88,6 +105,7 @@ func (p *putFactory) Start() error {
 	// joggers
 	r.workers = mpather.NewWorkerGroup(&mpather.WorkerGroupOpts{
 		Callback:  r.do,
+		Priority:  hotFirst,
 		Slab:      slab,
 		QueueSize: mirror.Burst,
 	})
@@ -112,27 +130,118 @@ func (p *putFactory) WhenPrevIsRunning(xprev xreg.Renewable) (xreg.WPR, error) {
 
 // (one worker per mountpath)
 func (r *XactPut) do(lom *core.LOM, buf []byte) {
-	copies := int(lom.Bprops().Mirror.Copies)
+	mirror := r.curMirror(lom)
+	if mirror == nil {
+		r.skipped.Inc()
+		r.DecPending()
+		core.FreeLOM(lom)
+		return
+	}
 
-	lom.Lock(true)
-	size, err := addCopies(lom, copies, buf)
-	lom.Unlock(true)
+	r.throttle(lom)
+
+	// high memory pressure: one mountpath worker at a time
+	if r.mempr.Load() >= memsys.PressureHigh {
+		r.memsema <- struct{}{}
+		defer func() { <-r.memsema }()
+	}
+
+	size, err := lockAddCopies(lom, int(mirror.Copies), buf, &r.stats)
 
 	if err != nil {
 		r.AddErr(err, 5, cos.SmoduleMirror)
-	} else {
+	}
+	if err == nil || size > 0 { // including partial success (errInsuffMpaths)
 		r.ObjsAdd(1, size)
 	}
+	if mirror.Batching() {
+		r.pause(lom.Mountpath().Path, mirror)
+	}
 	r.DecPending() // (see IncPending below)
 	core.FreeLOM(lom)
 }
 
+// (re)check the bucket's current mirroring policy that may have changed since the object
+// was queued - e.g., mirroring disabled mid-run, or the bucket destroyed;
+// returns nil when there's nothing to do
+func (r *XactPut) curMirror(lom *core.LOM) *cmn.MirrorConf {
+	bmd := core.T.Bowner().Get()
+	props, present := bmd.Get(lom.Bck())
+	if !present || !props.Mirror.Enabled {
+		return nil
+	}
+	return &props.Mirror
+}
+
+// back off while foreground traffic keeps the disks busy, so that mirroring does not
+// degrade user-visible GET/PUT latency:
+//   - pause while the source mountpath, or else all the other (destination) mountpaths,
+//     are utilized above disk.disk_util_high_wm, and resume as soon as it drops;
+//   - sleep doubles every time but the total is bounded (see throttleMaxWait) to not starve
+func (r *XactPut) throttle(lom *core.LOM) {
+	hwm := r.config.Disk.DiskUtilHighWM
+	if hwm <= 0 {
+		return
+	}
+	var (
+		total time.Duration
+		sleep = throttleMinSleep
+	)
+	for total < throttleMaxWait && busy(lom.Mountpath().Path, hwm) {
+		select {
+		case <-r.ChanAbort():
+			return
+		case <-time.After(sleep):
+		}
+		total += sleep
+		sleep = min(2*sleep, throttleMaxWait-total)
+	}
+	if total > 0 {
+		r.throttled.Inc()
+	}
+}
+
+func busy(src string, hwm int64) bool {
+	utils := fs.GetAllMpathUtils()
+	if utils.Get(src) >= hwm {
+		return true
+	}
+	for mpath := range fs.GetAvail() {
+		if mpath != src && utils.Get(mpath) < hwm {
+			return false
+		}
+	}
+	return len(fs.GetAvail()) > 1
+}
+
+// pause upon every (mirror.batch_size) objects - to smooth disk I/O under heavy ingest;
+// (the counter is only accessed by the mountpath's own worker)
+func (r *XactPut) pause(mpath string, mirror *cmn.MirrorConf) {
+	cnt, ok := r.batches[mpath]
+	if !ok {
+		return // (mountpath added at runtime)
+	}
+	*cnt++
+	if *cnt < mirror.BatchSize {
+		return
+	}
+	*cnt = 0
+	select {
+	case <-r.ChanAbort():
+	case <-time.After(mirror.BatchPause.D()):
+	}
+}
+
+// when there's a backlog, mirror the most recently accessed (hot) objects first
+func hotFirst(lom *core.LOM) int64 { return lom.AtimeUnix() }
+
 // control logic: stop and idle timer
 // (LOMs get dispatched directly to workers)
 func (r *XactPut) Run(*sync.WaitGroup) {
 	var err error
 	nlog.Infoln(r.Name())
 	r.config = cmn.GCO.Get()
+	core.T.PageMM().RegPressureCB(r.ID(), r.pressureCB)
 	r.workers.Run()
 loop:
 	for {
@@ -145,13 +254,21 @@ loop:
 		}
 	}
 
+	core.T.PageMM().UnregPressureCB(r.ID())
 	err = r.stop()
 	if err != nil {
 		r.AddErr(err)
 	}
+	replEvents.flush()
 	r.Finish()
 }
 
+func (r *XactPut) pressureCB(pressure int) {
+	if prev := r.mempr.Swap(int32(pressure)); prev != int32(pressure) && pressure >= memsys.PressureHigh {
+		nlog.Warningln(r.Name(), "memory pressure", memsys.PressureText(pressure), "- reducing concurrency")
+	}
+}
+
 // main method
 func (r *XactPut) Repl(lom *core.LOM) {
 	debug.Assert(!r.Finished(), r.String())
@@ -201,6 +318,12 @@ func (r *XactPut) stop() (err error) {
 		r.SubPending(n)
 		err = fmt.Errorf("%s: dropped %d object%s", r, n, cos.Plural(n))
 	}
+	if cnt := r.skipped.Load(); cnt > 0 {
+		nlog.Infof("%s: skipped %d object%s (mirroring disabled)", r, cnt, cos.Plural(int(cnt)))
+	}
+	if cnt := r.throttled.Load(); cnt > 0 {
+		nlog.Infof("%s: backed off %d time%s under high disk utilization", r, cnt, cos.Plural(int(cnt)))
+	}
 	if cnt := r.chanFull.Load(); (cnt >= 10 && cnt <= 20) || (cnt > 0 && cmn.Rom.FastV(5, cos.SmoduleMirror)) {
 		nlog.Errorln("work channel full (all mp workers)", r.String(), cnt)
 	}
@@ -211,6 +334,10 @@ func (r *XactPut) Snap() (snap *core.Snap) {
 	snap = &core.Snap{}
 	r.ToSnap(snap)
 
+	ext := r.stats.ext()
+	ext.Pending = r.Pending()
+	ext.Throughput = r.stats.tput(snap.StartTime)
+	snap.Ext = ext
 	snap.IdleX = r.IsIdle()
 	return
 }
@@ -5,14 +5,35 @@
 package mirror
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
 
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/fs"
 )
 
-// is under lock
+// under LOM's w-lock: forget copies that are gone (see core.LOM.MissingCopies)
+func delMissingCopies(lom *core.LOM) (int, error) {
+	// force reloading metadata
+	lom.UncacheUnless()
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return 0, err
+	}
+	return lom.DelMissingCopies()
+}
+
+// under LOM's w-lock
+//   - removes surplus copies down to the specified number, never touching the main (HRW) replica;
+//   - drops copies that reside on the mountpaths with the least free space first,
+//     and on the most utilized ones in case of a tie (see also: LeastUtilNoCopy)
 func delCopies(lom *core.LOM, copies int) (size int64, err error) {
 	// force reloading metadata
 	lom.UncacheUnless()
@@ -25,16 +46,27 @@ func delCopies(lom *core.LOM, copies int) (size int64, err error) {
 		return
 	}
 
-	copiesFQN := make([]string, 0, ndel)
-	for copyFQN := range lom.GetCopies() {
+	var (
+		mpathUtils = fs.GetAllMpathUtils()
+		candidates = make([]surplus, 0, lom.NumCopies())
+	)
+	for copyFQN, mi := range lom.GetCopies() {
 		if copyFQN == lom.FQN {
 			continue
 		}
-		copiesFQN = append(copiesFQN, copyFQN)
-		ndel--
-		if ndel == 0 {
-			break
+		candidates = append(candidates, surplus{fqn: copyFQN, avail: mi.CapCached().Avail, util: mpathUtils.Get(mi.Path)})
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].avail != candidates[j].avail {
+			return candidates[i].avail < candidates[j].avail
 		}
+		return candidates[i].util > candidates[j].util
+	})
+
+	ndel = min(ndel, len(candidates))
+	copiesFQN := make([]string, 0, ndel)
+	for _, c := range candidates[:ndel] {
+		copiesFQN = append(copiesFQN, c.fqn)
 	}
 
 	size = int64(len(copiesFQN)) * lom.SizeBytes()
@@ -45,9 +77,94 @@ func delCopies(lom *core.LOM, copies int) (size int64, err error) {
 	return
 }
 
+// (delCopies) candidate copy to remove
+type surplus struct {
+	fqn   string
+	avail uint64
+	util  int64
+}
+
+// fewer (eligible) mountpaths than the requested number of copies
+// (e.g., some mountpaths are disabled or full)
+var errInsuffMpaths = errors.New("insufficient number of mountpaths")
+
+// max number of replicas of a given object to create concurrently (on distinct mountpaths)
+const maxParallelCopies = 4
+
+// bounded retries (with exponential backoff) on transient errors - see lockAddCopies
+const (
+	maxCopyRetries = 3
+	copyRetrySleep = 100 * time.Millisecond
+)
+
+// min interval to sample mirroring throughput (see copyStats.tput)
+const tputInterval = time.Second
+
+type (
+	// (runtime) mirroring counters - see addCopies
+	copyStats struct {
+		copies    atomic.Int64 // replicas created
+		bytes     atomic.Int64 // bytes mirrored
+		failed    atomic.Int64 // failed to create replica
+		rollbacks atomic.Int64 // replica created but failed to get added to the object's metadata
+		retries   atomic.Int64 // retried upon transient errors (see lockAddCopies)
+		repaired  atomic.Int64 // objects with missing (e.g., lost) copies that were restored (x-make-n-copies)
+		// throughput sampling (see tput)
+		mu    sync.Mutex
+		tsamp int64 // mono time of the last sample
+		bsamp int64 // bytes at the last sample
+		rate  int64 // bytes per second
+	}
+	// extended x-put-copies and x-make-n-copies statistics (see xaction snapshots)
+	ExtMirrorStats struct {
+		Copies    int64 `json:"mirror.copies.n,string"`
+		Bytes     int64 `json:"mirror.copies.size,string"`
+		Failed    int64 `json:"mirror.copies.err.n,string"`
+		Rollbacks int64 `json:"mirror.rollback.n,string"`
+		Retries   int64 `json:"mirror.retry.n,string"`
+		Repaired  int64 `json:"mirror.repaired.n,string"`
+		// progress
+		Pending    int64 `json:"mirror.pending.n,string"`  // queued, not yet processed (x-put-copies)
+		Throughput int64 `json:"mirror.throughput,string"` // current rate of mirroring, bytes per second
+	}
+)
+
+func (cs *copyStats) ext() *ExtMirrorStats {
+	return &ExtMirrorStats{
+		Copies:    cs.copies.Load(),
+		Bytes:     cs.bytes.Load(),
+		Failed:    cs.failed.Load(),
+		Rollbacks: cs.rollbacks.Load(),
+		Retries:   cs.retries.Load(),
+		Repaired:  cs.repaired.Load(),
+	}
+}
+
+// current throughput: bytes mirrored per second over the interval (of at least
+// tputInterval) between the two most recent samples; the first interval starts
+// when the xaction does
+func (cs *copyStats) tput(started time.Time) int64 {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	now := mono.NanoTime()
+	if cs.tsamp == 0 {
+		cs.tsamp = now - int64(time.Since(started))
+	}
+	if elapsed := now - cs.tsamp; elapsed >= int64(tputInterval) {
+		b := cs.bytes.Load()
+		cs.rate = (b - cs.bsamp) * int64(time.Second) / elapsed
+		cs.tsamp, cs.bsamp = now, b
+	}
+	return cs.rate
+}
+
 // under LOM's w-lock => TODO: a finer-grade mechanism to write-protect
 // metadata only, md.copies in this case
-func addCopies(lom *core.LOM, copies int, buf []byte) (size int64, err error) {
+//   - selects (copies - 1) distinct mountpaths (see LeastUtilNoCopyN);
+//   - copies to up to maxParallelCopies of them concurrently, and then
+//     adds the new replicas to the object's metadata one at a time;
+//   - if there are not enough, keeps the copies made so far and returns errInsuffMpaths
+func addCopies(lom *core.LOM, copies int, buf []byte, stats *copyStats) (size int64, err error) {
 	// Reload metadata, it is necessary to have it fresh.
 	lom.UncacheUnless()
 	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
@@ -62,20 +179,113 @@ func addCopies(lom *core.LOM, copies int, buf []byte) (size int64, err error) {
 	//  While copying we may find out that some copies do not exist -
 	//  these copies will be removed and `NumCopies()` will decrease.
 	for lom.NumCopies() < copies {
-		var mi *fs.Mountpath
-		if mi = lom.LeastUtilNoCopy(); mi == nil {
-			err = fmt.Errorf("%s (copies=%d): cannot find dst mountpath", lom, lom.NumCopies())
+		var (
+			n   = lom.NumCopies()
+			mis = lom.LeastUtilNoCopyN(min(copies-n, maxParallelCopies))
+		)
+		if len(mis) == 0 {
+			err = fmt.Errorf("%w: %s has %d copies (expecting %d)", errInsuffMpaths, lom, n, copies)
 			return
 		}
-		if err = lom.Copy(mi, buf); err != nil {
+		err = parCopies(lom, mis, buf, stats)
+		if added := lom.NumCopies() - n; added > 0 {
+			size += int64(added) * lom.SizeBytes() // (including partial success)
+		}
+		if err != nil {
 			nlog.Errorln(err)
 			return
 		}
-		size += lom.SizeBytes()
 	}
 	return
 }
 
+// creates replicas on the given (distinct) mountpaths concurrently;
+// serializes updating (and persisting) the object's metadata -
+// successfully created replicas are added even if some others fail
+// (only the first goroutine gets to use the caller's `buf`)
+func parCopies(lom *core.LOM, mis []*fs.Mountpath, buf []byte, stats *copyStats) (err error) {
+	var (
+		copyFQNs = make([]string, len(mis))
+		errs     = make([]error, len(mis))
+	)
+	if len(mis) == 1 {
+		copyFQNs[0], errs[0] = lom.CopyData(mis[0], buf)
+	} else {
+		wg := &sync.WaitGroup{}
+		wg.Add(len(mis))
+		for i, mi := range mis {
+			var b []byte
+			if i == 0 {
+				b = buf
+			}
+			go func(i int, mi *fs.Mountpath, b []byte) {
+				copyFQNs[i], errs[i] = lom.CopyData(mi, b)
+				wg.Done()
+			}(i, mi, b)
+		}
+		wg.Wait()
+	}
+
+	for i, mi := range mis {
+		if errs[i] != nil {
+			stats.failed.Inc()
+		} else if errs[i] = lom.AddCopyPersist(copyFQNs[i], mi); errs[i] != nil {
+			stats.rollbacks.Inc()
+		} else {
+			stats.copies.Inc()
+			stats.bytes.Add(lom.SizeBytes())
+			replEvents.add(lom, mi)
+			continue
+		}
+		if err == nil {
+			err = errs[i]
+		}
+	}
+	return
+}
+
+// addCopies with retries: w-locks the object for the duration of each attempt
+// and backs off with the lock released - not to block the object's readers and writers
+// (a failed attempt keeps the replicas added so far; the next one recreates the rest)
+func lockAddCopies(lom *core.LOM, copies int, buf []byte, stats *copyStats) (size int64, err error) {
+	err = withRetry(lom, stats, func() error {
+		lom.Lock(true)
+		n, err := addCopies(lom, copies, buf, stats)
+		lom.Unlock(true)
+		size += n
+		return err
+	})
+	return
+}
+
+// retries transient errors up to maxCopyRetries times, doubling the sleep each time;
+// permanent errors (e.g., bad checksum) are returned right away
+// NOTE: must be called without holding the object's lock
+func withRetry(lom *core.LOM, stats *copyStats, cb func() error) (err error) {
+	sleep := copyRetrySleep
+	for i := 0; ; i++ {
+		if err = cb(); err == nil || i >= maxCopyRetries || !isErrTransient(err) {
+			return
+		}
+		stats.retries.Inc()
+		nlog.Warningf("%s: retrying in %v (attempt %d) upon transient error: %v", lom.Cname(), sleep, i+1, err)
+		time.Sleep(sleep)
+		sleep *= 2
+	}
+}
+
+// transient I/O errors that may clear on their own, e.g. momentarily busy disk;
+// all the rest (including corrupted data, missing source, and out of space) is considered permanent
+// (OOS is not expected to clear within retries - see x-make-n-copies abort)
+func isErrTransient(err error) bool {
+	var errCksum *cos.ErrBadCksum
+	if errors.As(err, &errCksum) {
+		return false
+	}
+	return errors.Is(err, syscall.EIO) || errors.Is(err, syscall.EBUSY) || errors.Is(err, syscall.EAGAIN) ||
+		errors.Is(err, syscall.EINTR)
+}
+
 func drainWorkCh(workCh chan core.LIF) (n int) {
 	for {
 		select {
@@ -0,0 +1,470 @@
+// Package mirror provides local mirroring and replica management
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package mirror
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/ios"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/tools/readers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// counts replica-created stats (see evBatcher.add)
+type replStats struct {
+	mock.StatsTracker
+	mu   sync.Mutex
+	vals map[string]int64
+}
+
+func (s *replStats) AddMany(nvs ...cos.NamedVal64) {
+	s.mu.Lock()
+	if s.vals == nil {
+		s.vals = make(map[string]int64, 2)
+	}
+	for _, nv := range nvs {
+		s.vals[nv.Name] += nv.Value
+	}
+	s.mu.Unlock()
+}
+
+func (s *replStats) Get(name string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.vals[name]
+}
+
+// NOTE: uses mountpaths configured by the suite (see utils_test.go)
+var _ = Describe("addCopies and delCopies", func() {
+	const (
+		testBucketName = "TEST_LOCAL_MIRROR_NCOPIES"
+		testObjectName = "ncopies/obj.ext"
+		testObjectSize = 1234
+	)
+	var (
+		bck = meta.Bck{Name: testBucketName, Provider: apc.AIS, Ns: cmn.NsGlobal, Props: &cmn.Bprops{
+			Cksum:  cmn.CksumConf{Type: cos.ChecksumXXHash},
+			Mirror: cmn.MirrorConf{Enabled: true, Copies: 2},
+			BID:    11,
+		}}
+		bmdMock = mock.NewBaseBownerMock(&bck)
+		lom     *core.LOM
+		iostats *mock.IOS
+		mpaths  []string
+	)
+	// (re)initialize the same mountpaths with the given iostater
+	reinit := func(iostater ios.IOS) {
+		fs.TestNew(iostater)
+		for _, mpath := range mpaths {
+			_ = cos.CreateDir(mpath)
+			_, _ = fs.Add(mpath, "daeID")
+		}
+	}
+
+	BeforeEach(func() {
+		mpaths = mpaths[:0]
+		for mpath := range fs.GetAvail() {
+			mpaths = append(mpaths, mpath)
+		}
+		iostats = mock.NewIOS() // (no disk stats)
+		reinit(iostats)
+		_ = mock.NewTarget(bmdMock)
+
+		lom = &core.LOM{ObjName: testObjectName}
+		Expect(lom.InitBck(bck.Bucket())).NotTo(HaveOccurred())
+		dir, base := filepath.Split(lom.FQN)
+		Expect(cos.CreateDir(dir)).NotTo(HaveOccurred())
+		r, err := readers.NewRandFile(dir, base, testObjectSize, cos.ChecksumNone)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r.Close()).NotTo(HaveOccurred())
+		lom.SetSize(testObjectSize)
+		Expect(lom.Persist()).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		for _, mpath := range mpaths {
+			_ = os.RemoveAll(mpath)
+		}
+		reinit(nil)
+	})
+
+	It("should make as many copies as there are mountpaths, each on a distinct mountpath", func() {
+		lom.Lock(true)
+		defer lom.Unlock(true)
+		_, err := addCopies(lom, len(mpaths), nil, &copyStats{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lom.NumCopies()).To(Equal(len(mpaths)))
+
+		seen := make(map[string]struct{}, len(mpaths))
+		for _, mi := range lom.GetCopies() {
+			seen[mi.Path] = struct{}{}
+		}
+		Expect(seen).To(HaveLen(len(mpaths)))
+	})
+
+	It("should keep the copies made when there are fewer mountpaths than requested", func() {
+		lom.Lock(true)
+		defer lom.Unlock(true)
+		_, err := addCopies(lom, len(mpaths)+1, nil, &copyStats{})
+		Expect(errors.Is(err, errInsuffMpaths)).To(BeTrue())
+		Expect(lom.NumCopies()).To(Equal(len(mpaths)))
+	})
+
+	It("should create multiple copies concurrently and record all of them", func() {
+		extra := []string{"/tmp/mirror-test_q/mirrortest_mpath/333", "/tmp/mirror-test_q/mirrortest_mpath/444"}
+		mpaths = append(mpaths, extra...)
+		defer func() {
+			mpaths = mpaths[:len(mpaths)-len(extra)]
+			for _, mpath := range extra {
+				_ = os.RemoveAll(mpath)
+			}
+		}()
+		reinit(iostats)
+
+		lom.Lock(true)
+		defer lom.Unlock(true)
+		stats := &copyStats{}
+		size, err := addCopies(lom, len(mpaths), make([]byte, testObjectSize), stats)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(size).To(BeEquivalentTo((len(mpaths) - 1) * testObjectSize))
+		Expect(lom.NumCopies()).To(Equal(len(mpaths)))
+
+		ext := stats.ext()
+		Expect(ext.Copies).To(BeEquivalentTo(len(mpaths) - 1))
+		Expect(ext.Bytes).To(BeEquivalentTo(size))
+		Expect(ext.Failed).To(BeZero())
+		Expect(ext.Rollbacks).To(BeZero())
+
+		for copyFQN := range lom.GetCopies() {
+			Expect(copyFQN).To(BeARegularFile())
+			copyLOM := &core.LOM{}
+			Expect(copyLOM.InitFQN(copyFQN, nil)).NotTo(HaveOccurred())
+			Expect(copyLOM.Load(false, true)).NotTo(HaveOccurred())
+			Expect(copyLOM.NumCopies()).To(Equal(len(mpaths)))
+		}
+	})
+
+	It("should report objects with missing copies", func() {
+		lom.Lock(true)
+		_, err := addCopies(lom, len(mpaths), nil, &copyStats{})
+		lom.Unlock(true)
+		Expect(err).NotTo(HaveOccurred())
+
+		names, err := VerifyCopies(&bck)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(names).To(BeEmpty())
+
+		for copyFQN := range lom.GetCopies() {
+			if copyFQN != lom.FQN {
+				Expect(os.Remove(copyFQN)).NotTo(HaveOccurred())
+				break
+			}
+		}
+		names, err = VerifyCopies(&bck)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(names).To(ConsistOf(testObjectName))
+	})
+
+	It("should stop making copies when mirroring gets disabled at runtime", func() {
+		hk.TestInit()
+		r := &XactPut{config: cmn.GCO.Get()}
+		r.DemandBase.Init(cos.GenUUID(), apc.ActPutCopies, &bck, 0)
+		r.workers = mpather.NewWorkerGroup(&mpather.WorkerGroupOpts{Callback: r.do, QueueSize: 4})
+		r.workers.Run()
+		defer r.workers.Stop()
+
+		repl := func() int {
+			l := core.AllocLOM(testObjectName)
+			Expect(l.InitBck(bck.Bucket())).NotTo(HaveOccurred())
+			r.Repl(l)
+			Eventually(r.Pending).Should(BeZero())
+
+			l = &core.LOM{ObjName: testObjectName}
+			Expect(l.InitBck(bck.Bucket())).NotTo(HaveOccurred())
+			Expect(l.Load(false, false)).NotTo(HaveOccurred())
+			return l.NumCopies()
+		}
+
+		// disable mirroring mid-run (compare with `ais bucket props set BUCKET mirror.enabled=false`)
+		props := bck.Props.Clone()
+		props.Mirror.Enabled = false
+		bmdMock.Set(&bck, props)
+		Expect(repl()).To(Equal(1))
+		Expect(r.skipped.Load()).To(BeEquivalentTo(1))
+
+		// and re-enable
+		bmdMock.Set(&bck, bck.Props)
+		Expect(repl()).To(Equal(2))
+		Expect(r.skipped.Load()).To(BeEquivalentTo(1))
+	})
+
+	It("should back off under high foreground load and resume when it drops", func() {
+		const hwm = 80
+		r := &XactPut{config: &cmn.Config{}}
+		r.config.Disk.DiskUtilHighWM = hwm
+		src := lom.Mountpath().Path
+		for _, mpath := range mpaths {
+			iostats.Utils.Set(mpath, 10)
+		}
+
+		started := time.Now()
+		r.throttle(lom)
+		Expect(time.Since(started)).To(BeNumerically("<", throttleMinSleep))
+		Expect(r.throttled.Load()).To(BeZero())
+
+		iostats.Utils.Set(src, hwm+10)
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			iostats.Utils.Set(src, hwm-10)
+		}()
+		r.throttle(lom)
+		Expect(time.Since(started)).To(BeNumerically(">=", 100*time.Millisecond))
+		Expect(time.Since(started)).To(BeNumerically("<", throttleMaxWait))
+		Expect(r.throttled.Load()).To(BeEquivalentTo(1))
+	})
+
+	It("should estimate additional capacity without making copies", func() {
+		for mpath, mi := range fs.GetAvail() {
+			mi.TestSetCapacity(fs.Capacity{Avail: cos.GiB})
+			iostats.Utils.Set(mpath, 10)
+		}
+		est, err := EstimateCopies(&bck, len(mpaths))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(est.Objs).To(BeEquivalentTo(1))
+		Expect(est.Size).To(BeEquivalentTo((len(mpaths) - 1) * testObjectSize))
+		Expect(est.Mpaths).To(HaveLen(len(mpaths) - 1))
+		Expect(est.Mpaths).NotTo(HaveKey(lom.Mountpath().Path))
+		Expect(est.Insuff).To(BeZero())
+		Expect(est.Fits).To(BeTrue())
+
+		est, err = EstimateCopies(&bck, len(mpaths)+1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(est.Insuff).To(BeEquivalentTo(1))
+
+		Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+		Expect(lom.NumCopies()).To(Equal(1))
+	})
+
+	It("should notify (in batches) when replicas are created", func() {
+		var (
+			received []ReplicaEvent
+			mu       sync.Mutex
+		)
+		RegReplicaCB(func(events []ReplicaEvent) {
+			mu.Lock()
+			received = append(received, events...)
+			mu.Unlock()
+		})
+		defer RegReplicaCB(nil)
+		numReceived := func() int {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(received)
+		}
+
+		lom.Lock(true)
+		_, err := addCopies(lom, len(mpaths), nil, &copyStats{})
+		lom.Unlock(true)
+		Expect(err).NotTo(HaveOccurred())
+		Consistently(numReceived, 100*time.Millisecond).Should(BeZero()) // (batched)
+
+		replEvents.flush() // (delivered asynchronously)
+		Eventually(numReceived, 5*time.Second).Should(Equal(len(mpaths) - 1))
+		mu.Lock()
+		defer mu.Unlock()
+		for _, ev := range received {
+			Expect(ev.ObjName).To(Equal(testObjectName))
+			Expect(ev.Src).To(Equal(lom.Mountpath().Path))
+			Expect(ev.Dst).NotTo(Equal(ev.Src))
+			Expect(ev.Size).To(BeEquivalentTo(testObjectSize))
+		}
+	})
+
+	It("should count created replicas regardless of the registered callback", func() {
+		tstats := &replStats{}
+		replEvents.tstats = tstats
+		defer func() { replEvents.tstats = nil }()
+		RegReplicaCB(func([]ReplicaEvent) {})
+		defer RegReplicaCB(nil)
+
+		lom.Lock(true)
+		_, err := addCopies(lom, len(mpaths), nil, &copyStats{})
+		lom.Unlock(true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tstats.Get(stats.ReplicaCreatedCount)).To(BeEquivalentTo(len(mpaths) - 1))
+		Expect(tstats.Get(stats.ReplicaCreatedSize)).To(BeEquivalentTo((len(mpaths) - 1) * testObjectSize))
+	})
+
+	Describe("delCopies", func() {
+		const mpath3 = "/tmp/mirror-test_q/mirrortest_mpath/333"
+
+		// adds a third mountpath (removed upon return) and makes a copy on each
+		withCopies := func(test func(others []*fs.Mountpath)) {
+			mpaths = append(mpaths, mpath3)
+			defer func() {
+				mpaths = mpaths[:len(mpaths)-1]
+				_ = os.RemoveAll(mpath3)
+			}()
+			reinit(iostats)
+
+			lom.Lock(true)
+			defer lom.Unlock(true)
+			_, err := addCopies(lom, len(mpaths), nil, &copyStats{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lom.NumCopies()).To(Equal(len(mpaths)))
+
+			others := make([]*fs.Mountpath, 0, len(mpaths)-1)
+			for copyFQN, mi := range lom.GetCopies() {
+				if copyFQN != lom.FQN {
+					others = append(others, mi)
+				}
+				mi.TestSetCapacity(fs.Capacity{Avail: cos.GiB})
+			}
+			Expect(others).To(HaveLen(2))
+			test(others)
+		}
+		remaining := func() map[string]struct{} {
+			paths := make(map[string]struct{}, lom.NumCopies())
+			for _, mi := range lom.GetCopies() {
+				paths[mi.Path] = struct{}{}
+			}
+			return paths
+		}
+
+		It("should drop the copy on the mountpath with the least free space", func() {
+			withCopies(func(others []*fs.Mountpath) {
+				others[1].TestSetCapacity(fs.Capacity{Avail: cos.MiB})
+				_, err := delCopies(lom, 2)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(lom.NumCopies()).To(Equal(2))
+				Expect(remaining()).To(HaveKey(lom.Mountpath().Path))
+				Expect(remaining()).To(HaveKey(others[0].Path))
+				Expect(remaining()).NotTo(HaveKey(others[1].Path))
+			})
+		})
+
+		It("should drop the copy on the most utilized mountpath when free space is the same", func() {
+			withCopies(func(others []*fs.Mountpath) {
+				iostats.Utils.Set(others[0].Path, 90)
+				iostats.Utils.Set(others[1].Path, 10)
+				_, err := delCopies(lom, 2)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(remaining()).To(HaveKey(others[1].Path))
+				Expect(remaining()).NotTo(HaveKey(others[0].Path))
+			})
+		})
+
+		It("should never remove the main replica", func() {
+			withCopies(func([]*fs.Mountpath) {
+				lom.Mountpath().TestSetCapacity(fs.Capacity{})
+				_, err := delCopies(lom, 1)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(lom.NumCopies()).To(Equal(1))
+				Expect(cos.Stat(lom.FQN)).NotTo(HaveOccurred())
+			})
+		})
+	})
+})
+
+var _ = Describe("XactPut batching", func() {
+	It("should pause upon every batch_size objects per mountpath", func() {
+		const pause = 100 * time.Millisecond
+		var (
+			r      = &XactPut{batches: map[string]*int{"/a": new(int), "/b": new(int)}}
+			mirror = &cmn.MirrorConf{BatchSize: 3, BatchPause: cos.Duration(pause)}
+		)
+		started := time.Now()
+		for range 2 {
+			r.pause("/a", mirror)
+			r.pause("/b", mirror)
+		}
+		Expect(time.Since(started)).To(BeNumerically("<", pause))
+
+		r.pause("/a", mirror)
+		Expect(time.Since(started)).To(BeNumerically(">=", pause))
+		Expect(*r.batches["/a"]).To(BeZero())
+		Expect(*r.batches["/b"]).To(Equal(2))
+	})
+})
+
+var _ = Describe("withRetry", func() {
+	var (
+		lom   = &core.LOM{ObjName: "retry"}
+		stats *copyStats
+	)
+	BeforeEach(func() { stats = &copyStats{} })
+
+	It("should retry transient errors and succeed when they clear", func() {
+		var calls int
+		err := withRetry(lom, stats, func() error {
+			if calls++; calls < 3 {
+				return &os.PathError{Op: "write", Path: "/tmp/x", Err: syscall.EIO}
+			}
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(calls).To(Equal(3))
+		Expect(stats.ext().Retries).To(BeEquivalentTo(2))
+	})
+
+	It("should give up after max retries", func() {
+		var calls int
+		err := withRetry(lom, stats, func() error { calls++; return syscall.EBUSY })
+		Expect(errors.Is(err, syscall.EBUSY)).To(BeTrue())
+		Expect(calls).To(Equal(maxCopyRetries + 1))
+	})
+
+	It("should not retry out-of-space", func() {
+		var calls int
+		err := withRetry(lom, stats, func() error { calls++; return syscall.ENOSPC })
+		Expect(cos.IsErrOOS(err)).To(BeTrue())
+		Expect(calls).To(Equal(1))
+	})
+
+	It("should not retry permanent errors", func() {
+		var calls int
+		err := withRetry(lom, stats, func() error {
+			calls++
+			return cos.NewErrDataCksum(cos.NewCksum(cos.ChecksumXXHash, "a"), cos.NewCksum(cos.ChecksumXXHash, "b"))
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(calls).To(Equal(1))
+		Expect(stats.ext().Retries).To(BeZero())
+	})
+})
+
+var _ = Describe("copyStats", func() {
+	It("should report current throughput", func() {
+		stats := &copyStats{}
+		started := time.Now().Add(-2 * time.Second)
+		stats.bytes.Add(2 * cos.MiB)
+		Expect(stats.tput(started)).To(BeNumerically("~", cos.MiB, cos.KiB*16))
+
+		// (same sample until the next interval)
+		stats.bytes.Add(cos.MiB)
+		Expect(stats.tput(started)).To(BeNumerically("~", cos.MiB, cos.KiB*16))
+
+		time.Sleep(tputInterval)
+		Expect(stats.tput(started)).To(BeNumerically("~", cos.MiB, cos.KiB*16))
+		time.Sleep(tputInterval)
+		Expect(stats.tput(started)).To(BeZero())
+	})
+})
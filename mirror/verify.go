@@ -0,0 +1,67 @@
+// Package mirror provides local mirroring and replica management
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package mirror
+
+import (
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// VerifyCopies walks all local mountpaths of a given bucket and returns the names
+// of objects that have one or more of their recorded copies missing on disk
+// (e.g., lost with a failed or detached mountpath);
+// this is the detection part of the repair (see x-make-n-copies) that can also
+// be used as a standalone health check - it does not modify anything
+func VerifyCopies(bck *meta.Bck) (names []string, err error) {
+	err = walkMains(bck, func(lom *core.LOM) {
+		missing := lom.MissingCopies()
+		if len(missing) == 0 {
+			return
+		}
+		nlog.Warningf("%s: missing %d (out of %d) copies: %v", lom.Cname(), len(missing), lom.NumCopies(), missing)
+		names = append(names, lom.ObjName)
+	})
+	if n := len(names); n > 0 {
+		nlog.Warningf("%s: %d object%s with missing copies", bck.Cname(""), n, cos.Plural(n))
+	}
+	return
+}
+
+// walks a given bucket and calls back with each loaded (and read-locked) main replica;
+// copies are skipped - they are accounted for via their respective mains
+func walkMains(bck *meta.Bck, cb func(lom *core.LOM)) error {
+	opts := &fs.WalkBckOpts{
+		WalkOpts: fs.WalkOpts{CTs: []string{fs.ObjectType}, Sorted: true},
+	}
+	opts.WalkOpts.Bck.Copy(bck.Bucket())
+	opts.Callback = func(fqn string, _ fs.DirEntry) error {
+		visitMain(fqn, bck, cb)
+		return nil
+	}
+	return fs.WalkBck(opts)
+}
+
+func visitMain(fqn string, bck *meta.Bck, cb func(lom *core.LOM)) {
+	lom := core.AllocLOM("")
+	defer core.FreeLOM(lom)
+	if err := lom.InitFQN(fqn, bck.Bucket()); err != nil {
+		return
+	}
+	if !lom.IsHRW() {
+		return
+	}
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if !cos.IsNotExist(err, 0) {
+			nlog.Warningln(err)
+		}
+		return
+	}
+	cb(lom)
+}
@@ -41,6 +41,7 @@ type (
 		IncErr(metric string)
 
 		GetStats() *Node
+		GetMetrics() *Node       // metrics only (compare w/ target's GetStats that also includes capacity)
 		GetStatsV322() *NodeV322 // [backward compatibility]
 
 		ResetStats(errorsOnly bool)
@@ -588,6 +588,8 @@ func (r *runner) GetStats() *Node {
 	return &Node{Tracker: ctracker}
 }
 
+func (r *runner) GetMetrics() *Node { return r.GetStats() }
+
 func (r *runner) GetStatsV322() (out *NodeV322) {
 	ds := r.GetStats()
 
@@ -63,6 +63,9 @@ const (
 	ErrMetadataCount = "err.md.n"
 	ErrIOCount       = "err.io.n"
 
+	// inline ETL transform timed out (see etl.InitMsgBase.ObjTimeout)
+	ErrETLTimeoutCount = "err.etl.timeout.n"
+
 	// target restarted (effectively, boolean)
 	RestartCount = "restart.n"
 
@@ -103,6 +106,11 @@ const (
 	LcacheEvictedCount   = core.LcacheEvictedCount
 	LcacheFlushColdCount = core.LcacheFlushColdCount
 
+	// mirror (replicas created; see also mirror.RegReplicaCB)
+	ReplicaCreatedCount = "mirror.replica.n"
+	ReplicaCreatedSize  = "mirror.replica.size"
+	ReplicaEvDropCount  = "mirror.replica.ev.drop.n"
+
 	// variable label used for prometheus disk metrics
 	diskMetricLabel = "disk"
 )
@@ -241,6 +249,7 @@ func (r *Trunner) RegMetrics(node *meta.Snode) {
 
 	r.reg(node, ErrMetadataCount, KindCounter)
 	r.reg(node, ErrIOCount, KindCounter)
+	r.reg(node, ErrETLTimeoutCount, KindCounter)
 
 	// streams
 	r.reg(node, StreamsOutObjCount, KindCounter)
@@ -269,6 +278,11 @@ func (r *Trunner) RegMetrics(node *meta.Snode) {
 	r.reg(node, LcacheEvictedCount, KindCounter)
 	r.reg(node, LcacheFlushColdCount, KindCounter)
 
+	// mirror
+	r.reg(node, ReplicaCreatedCount, KindCounter)
+	r.reg(node, ReplicaCreatedSize, KindSize)
+	r.reg(node, ReplicaEvDropCount, KindCounter)
+
 	// Prometheus
 	r.core.initProm(node)
 }
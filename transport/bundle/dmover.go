@@ -8,6 +8,7 @@ package bundle
 import (
 	"fmt"
 	"io"
+	"sync"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -47,6 +48,15 @@ type (
 			opened atomic.Bool
 			laterx atomic.Bool
 		}
+		// flow control: max bytes sent but not yet completed (see Extra.WindowSize)
+		window struct {
+			wake     chan struct{} // signaled upon release (and close)
+			mu       sync.Mutex
+			inflight atomic.Int64
+			size     int64
+		}
+		// per-opcode (data vs. control) send counters; opcode zero is regular data
+		opcs       [numOpcSlots]opcSlot
 		sizePDU    int32
 		maxHdrSize int32
 	}
@@ -56,9 +66,31 @@ type (
 		Config      *cmn.Config
 		Compression string
 		Multiplier  int
+		WindowSize  int64 // max in-flight (sent but not yet completed) bytes; zero: unlimited
 		SizePDU     int32
 		MaxHdrSize  int32
 	}
+	// number of successfully sent objects and their total size - per opcode
+	OpcStat struct {
+		Cnt  int64 `json:"n,string"`
+		Size int64 `json:"size,string"`
+	}
+	// lock-free counters: a given data mover sends only a few distinct opcodes -
+	// each gets assigned a slot upon first use (the key being opcode + 1; zero: free)
+	opcSlot struct {
+		key  atomic.Int64
+		cnt  atomic.Int64
+		size atomic.Int64
+	}
+)
+
+const (
+	// per-opcode counters (when out of slots, the last one counts all remaining opcodes)
+	numOpcSlots = 8
+	OpcOther    = -1 // OpcodeStats key for the opcodes that did not get a slot of their own
+
+	// Send on a full window: how often to check whether the xaction was aborted
+	windowCheckAbort = time.Second
 )
 
 var _ core.DM = (*DataMover)(nil) // via t.CopyObject()
@@ -74,6 +106,13 @@ func NewDataMover(trname string, recvCB transport.RecvObj, owt cmn.OWT, extra Ex
 	dm.owt = owt
 	dm.multiplier = extra.Multiplier
 	dm.sizePDU, dm.maxHdrSize = extra.SizePDU, extra.MaxHdrSize
+	if extra.WindowSize < 0 {
+		return nil, fmt.Errorf("invalid window size %d", extra.WindowSize)
+	}
+	dm.window.size = extra.WindowSize
+	if dm.window.size > 0 {
+		dm.window.wake = make(chan struct{}, 1)
+	}
 	switch extra.Compression {
 	case "":
 		dm.compression = apc.CompressNever
@@ -192,6 +231,7 @@ func (dm *DataMover) Close(err error) {
 	if dm.useACKs() {
 		dm.ack.streams.Close(err == nil)
 	}
+	dm.wakeup()
 }
 
 func (dm *DataMover) Abort() {
@@ -199,6 +239,7 @@ func (dm *DataMover) Abort() {
 	if dm.useACKs() {
 		dm.ack.streams.Abort()
 	}
+	dm.wakeup()
 }
 
 func (dm *DataMover) UnregRecv() {
@@ -221,26 +262,154 @@ func (dm *DataMover) UnregRecv() {
 	}
 }
 
+// when the window is configured (and full) Send blocks until previously sent objects complete
 func (dm *DataMover) Send(obj *transport.Obj, roc cos.ReadOpenCloser, tsi *meta.Snode) (err error) {
+	if size := obj.Size(); dm.window.size > 0 && size > 0 {
+		if err = dm.acquire(size); err != nil {
+			_doCmpl(obj, roc, err)
+			return err
+		}
+		cb := obj.Callback
+		obj.Callback = func(hdr *transport.ObjHdr, rc io.ReadCloser, arg any, err error) {
+			dm.release(size)
+			if cb != nil {
+				cb(hdr, rc, arg, err)
+			}
+		}
+	}
+	opc, size := obj.Hdr.Opcode, obj.Size()
 	err = dm.data.streams.Send(obj, roc, tsi)
-	if err == nil && !transport.ReservedOpcode(obj.Hdr.Opcode) {
-		dm.xctn.OutObjsAdd(1, obj.Size())
+	if err == nil {
+		dm.opcAdd(opc, size)
+		if !transport.ReservedOpcode(opc) {
+			dm.xctn.OutObjsAdd(1, size)
+		}
 	}
 	return
 }
 
 func (dm *DataMover) ACK(hdr *transport.ObjHdr, cb transport.ObjSentCB, tsi *meta.Snode) error {
-	return dm.ack.streams.Send(&transport.Obj{Hdr: *hdr, Callback: cb}, nil, tsi)
+	opc, size := hdr.Opcode, hdr.ObjSize()
+	err := dm.ack.streams.Send(&transport.Obj{Hdr: *hdr, Callback: cb}, nil, tsi)
+	if err == nil {
+		dm.opcAdd(opc, size)
+	}
+	return err
 }
 
+// (counted once regardless of the number of destinations)
 func (dm *DataMover) Bcast(obj *transport.Obj, roc cos.ReadOpenCloser) error {
-	return dm.data.streams.Send(obj, roc)
+	opc, size := obj.Hdr.Opcode, obj.Size()
+	err := dm.data.streams.Send(obj, roc)
+	if err == nil {
+		dm.opcAdd(opc, size)
+	}
+	return err
+}
+
+// returns per-opcode send counters (nil when nothing was sent)
+func (dm *DataMover) OpcodeStats() (out map[int]OpcStat) {
+	for i := range dm.opcs {
+		slot := &dm.opcs[i]
+		key := slot.key.Load()
+		if key == 0 {
+			break
+		}
+		if out == nil {
+			out = make(map[int]OpcStat, 4)
+		}
+		opc := int(key - 1)
+		if i == numOpcSlots-1 {
+			opc = OpcOther
+		}
+		out[opc] = OpcStat{Cnt: slot.cnt.Load(), Size: slot.size.Load()}
+	}
+	return out
 }
 
+// bytes sent but not yet completed (is always zero when the window is not configured)
+func (dm *DataMover) InflightBytes() int64 { return dm.window.inflight.Load() }
+
 //
 // private
 //
 
+// block while the window is full; a single object larger than the window is still
+// allowed when nothing else is in flight
+// - waiters wake up one another in a chain (see wakeup) - upon release, abort, and close
+// - and, in addition, periodically check whether the xaction was aborted
+func (dm *DataMover) acquire(size int64) error {
+	for {
+		dm.window.mu.Lock()
+		cur := dm.window.inflight.Load()
+		if cur == 0 || cur+size <= dm.window.size {
+			cur = dm.window.inflight.Add(size)
+			dm.window.mu.Unlock()
+			if cur < dm.window.size {
+				dm.wakeup() // still room for others
+			}
+			return nil
+		}
+		dm.window.mu.Unlock()
+
+		if dm.xctn != nil && dm.xctn.IsAborted() {
+			dm.wakeup()
+			return dm.xctn.AbortErr()
+		}
+		if !dm.stage.opened.Load() {
+			dm.wakeup()
+			return fmt.Errorf("%s: closed", dm)
+		}
+		timer := time.NewTimer(windowCheckAbort)
+		select {
+		case <-dm.window.wake:
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+}
+
+func (dm *DataMover) wakeup() {
+	select {
+	case dm.window.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (dm *DataMover) opcAdd(opc int, size int64) {
+	var (
+		slot *opcSlot
+		key  = int64(opc) + 1
+	)
+	for i := range numOpcSlots - 1 {
+		s := &dm.opcs[i]
+		k := s.key.Load()
+		if k == 0 && (s.key.CAS(0, key) || s.key.Load() == key) {
+			slot = s
+			break
+		}
+		if k == key {
+			slot = s
+			break
+		}
+	}
+	if slot == nil {
+		slot = &dm.opcs[numOpcSlots-1]
+		slot.key.CAS(0, int64(OpcOther))
+	}
+	slot.cnt.Inc()
+	if size > 0 {
+		slot.size.Add(size)
+	}
+}
+
+func (dm *DataMover) release(size int64) {
+	dm.window.mu.Lock()
+	dm.window.inflight.Sub(size)
+	dm.window.mu.Unlock()
+	dm.wakeup()
+}
+
 func (dm *DataMover) quicb(_ time.Duration /*accum. sleep time*/) core.QuiRes {
 	if dm.stage.laterx.CAS(true, false) {
 		return core.QuiActive
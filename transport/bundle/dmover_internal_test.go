@@ -0,0 +1,124 @@
+// Package bundle provides multi-streaming transport with the functionality
+// to dynamically (un)register receive endpoints, establish long-lived flows, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package bundle
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const windowSize = 100
+
+func newWindowDM(t *testing.T) *DataMover {
+	dm, err := NewDataMover("window-test", nil, cmn.OwtPut, Extra{Config: &cmn.Config{}, WindowSize: windowSize})
+	tassert.CheckFatal(t, err)
+	dm.stage.opened.Store(true)
+	return dm
+}
+
+// acquire asynchronously; make sure it blocks
+func acquireBlocked(t *testing.T, dm *DataMover, size int64) chan error {
+	ch := make(chan error, 1)
+	go func() { ch <- dm.acquire(size) }()
+	select {
+	case err := <-ch:
+		t.Fatalf("expected to block on a full window (inflight %d), got %v", dm.InflightBytes(), err)
+	case <-time.After(100 * time.Millisecond):
+	}
+	return ch
+}
+
+func waitAcquired(t *testing.T, ch chan error) error {
+	select {
+	case err := <-ch:
+		return err
+	case <-time.After(windowCheckAbort + time.Second):
+		t.Fatal("timed out waiting for blocked sender")
+	}
+	return nil
+}
+
+func TestWindowCompletion(t *testing.T) {
+	dm := newWindowDM(t)
+	tassert.CheckFatal(t, dm.acquire(60))
+	tassert.CheckFatal(t, dm.acquire(40))
+
+	ch := acquireBlocked(t, dm, 10)
+	dm.release(60) // (completion)
+	tassert.CheckFatal(t, waitAcquired(t, ch))
+	tassert.Errorf(t, dm.InflightBytes() == 50, "expected 50 in-flight bytes, got %d", dm.InflightBytes())
+
+	// single object larger than the window goes through when nothing else is in flight
+	ch = acquireBlocked(t, dm, 2*windowSize)
+	dm.release(40)
+	dm.release(10)
+	tassert.CheckFatal(t, waitAcquired(t, ch))
+}
+
+func TestWindowAbort(t *testing.T) {
+	dm := newWindowDM(t)
+	xctn := mock.NewXact(t.Name())
+	dm.SetXact(xctn)
+	tassert.CheckFatal(t, dm.acquire(windowSize))
+
+	var (
+		chs      = []chan error{acquireBlocked(t, dm, 1), acquireBlocked(t, dm, 1)}
+		errAbort = errors.New("test abort")
+	)
+	xctn.Abort(errAbort)
+	for _, ch := range chs {
+		err := waitAcquired(t, ch)
+		tassert.Errorf(t, errors.Is(err, errAbort), "expected abort error, got %v", err)
+	}
+	tassert.Errorf(t, dm.InflightBytes() == windowSize, "expected %d in-flight bytes, got %d", windowSize, dm.InflightBytes())
+}
+
+func TestWindowClose(t *testing.T) {
+	dm := newWindowDM(t)
+	tassert.CheckFatal(t, dm.acquire(windowSize))
+
+	ch := acquireBlocked(t, dm, 1)
+	dm.stage.opened.Store(false) // (as in Close)
+	dm.wakeup()
+	err := waitAcquired(t, ch)
+	tassert.Errorf(t, err != nil, "expected error upon close")
+}
+
+func TestOpcodeStats(t *testing.T) {
+	dm := newWindowDM(t)
+	tassert.Errorf(t, dm.OpcodeStats() == nil, "expected no stats")
+
+	const numOpcodes = numOpcSlots + 2
+	wg := &sync.WaitGroup{}
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			for opc := range numOpcodes {
+				dm.opcAdd(opc, int64(opc))
+			}
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+
+	stats := dm.OpcodeStats()
+	tassert.Fatalf(t, len(stats) == numOpcSlots, "expected %d entries, got %v", numOpcSlots, stats)
+	var total int64
+	for opc, st := range stats {
+		total += st.Cnt
+		if opc != OpcOther {
+			tassert.Errorf(t, st.Cnt == 4 && st.Size == 4*int64(opc), "opcode %d: unexpected %+v", opc, st)
+		}
+	}
+	tassert.Errorf(t, total == 4*numOpcodes, "expected total count %d, got %d (%v)", 4*numOpcodes, total, stats)
+	tassert.Errorf(t, stats[OpcOther].Cnt == 4*(numOpcodes-numOpcSlots+1), "unexpected 'other': %+v", stats[OpcOther])
+}
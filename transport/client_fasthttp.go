@@ -51,7 +51,7 @@ func NewIntraDataClient() Client {
 		ReadBufferSize:  rbuf,
 		WriteBufferSize: wbuf,
 	}
-	if config.Net.HTTP.UseHTTPS {
+	if config.Net.HTTP.UseDataTLS() {
 		tlsConfig, err := cmn.NewTLS(config.Net.HTTP.ToTLS())
 		if err != nil {
 			cos.ExitLog(err)
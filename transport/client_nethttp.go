@@ -47,7 +47,7 @@ func NewIntraDataClient() (client *http.Client) {
 		WriteBufferSize: wbuf,
 		ReadBufferSize:  rbuf,
 	}
-	if config.Net.HTTP.UseHTTPS {
+	if config.Net.HTTP.UseDataTLS() {
 		client = cmn.NewClientTLS(cargs, config.Net.HTTP.ToTLS())
 	} else {
 		client = cmn.NewClient(cargs)
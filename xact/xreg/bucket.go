@@ -24,9 +24,11 @@ type (
 		Phase   string
 	}
 	TCObjsArgs struct {
-		BckFrom *meta.Bck
-		BckTo   *meta.Bck
-		DP      core.DP
+		BckFrom    *meta.Bck
+		BckTo      *meta.Bck
+		DP         core.DP
+		WindowSize int64 // data mover's flow control (see apc.CopyBckMsg)
+		BwLimit    int64 // per-target bandwidth limit, bytes per second (ditto)
 	}
 	DsortArgs struct {
 		BckFrom *meta.Bck
@@ -44,6 +46,7 @@ type (
 	MNCArgs struct {
 		Tag    string
 		Copies int
+		Repair bool // check each object for missing (e.g., lost) copies
 	}
 	LsoArgs struct {
 		Msg *apc.LsoMsg
@@ -73,6 +76,7 @@ func RenewECEncode(bck *meta.Bck, uuid, phase string) RenewRes {
 	return RenewBucketXact(apc.ActECEncode, bck, Args{Custom: &ECEncodeArgs{Phase: phase}, UUID: uuid})
 }
 
+// (upon mountpath changes: restore copies that may have been lost)
 func RenewMakeNCopies(uuid, tag string) {
 	var (
 		cfg      = cmn.GCO.Get()
@@ -81,7 +85,7 @@ func RenewMakeNCopies(uuid, tag string) {
 	)
 	bmd.Range(&provider, nil, func(bck *meta.Bck) bool {
 		if bck.Props.Mirror.Enabled {
-			rns := RenewBckMakeNCopies(bck, uuid, tag, int(bck.Props.Mirror.Copies))
+			rns := RenewBckMakeNCopies(bck, uuid, tag, int(bck.Props.Mirror.Copies), true /*repair*/)
 			if rns.Err == nil && !rns.IsRunning() {
 				xact.GoRunW(rns.Entry.Get())
 			}
@@ -93,7 +97,7 @@ func RenewMakeNCopies(uuid, tag string) {
 		ns := cfg.Backend.Providers[name]
 		bmd.Range(&name, &ns, func(bck *meta.Bck) bool {
 			if bck.Props.Mirror.Enabled {
-				rns := RenewBckMakeNCopies(bck, uuid, tag, int(bck.Props.Mirror.Copies))
+				rns := RenewBckMakeNCopies(bck, uuid, tag, int(bck.Props.Mirror.Copies), true /*repair*/)
 				if rns.Err == nil && !rns.IsRunning() {
 					xact.GoRunW(rns.Entry.Get())
 				}
@@ -103,8 +107,8 @@ func RenewMakeNCopies(uuid, tag string) {
 	}
 }
 
-func RenewBckMakeNCopies(bck *meta.Bck, uuid, tag string, copies int) (res RenewRes) {
-	e := dreg.bckXacts[apc.ActMakeNCopies].New(Args{Custom: &MNCArgs{tag, copies}, UUID: uuid}, bck)
+func RenewBckMakeNCopies(bck *meta.Bck, uuid, tag string, copies int, repair bool) (res RenewRes) {
+	e := dreg.bckXacts[apc.ActMakeNCopies].New(Args{Custom: &MNCArgs{tag, copies, repair}, UUID: uuid}, bck)
 	return dreg.renew(e, bck)
 }
 
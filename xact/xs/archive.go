@@ -102,7 +102,7 @@ func (p *archFactory) Start() (err error) {
 	p.xctn = r
 	r.DemandBase.Init(p.UUID() /*== p.Args.UUID above*/, p.kind, p.Bck /*from*/, xact.IdleDefault)
 
-	if err := p.newDM(p.Args.UUID /*trname*/, r.recv, r.config, cmn.OwtPut, 0 /*pdu*/); err != nil {
+	if err := p.newDM(p.Args.UUID /*trname*/, r.recv, nil /*ack*/, r.config, cmn.OwtPut, 0 /*pdu*/, 0 /*window*/); err != nil {
 		return err
 	}
 	if r.p.dm != nil {
@@ -90,7 +90,9 @@ func (p *streamingF) genBEID(fromBck, toBck *meta.Bck) (string, error) {
 	return "", err
 }
 
-func (p *streamingF) newDM(trname string, recv transport.RecvObj, config *cmn.Config, owt cmn.OWT, sizePDU int32) (err error) {
+// (recvAck is optional; ditto window - see bundle.Extra.WindowSize)
+func (p *streamingF) newDM(trname string, recv, recvAck transport.RecvObj, config *cmn.Config, owt cmn.OWT, sizePDU int32,
+	window int64) (err error) {
 	smap := core.T.Sowner().Get()
 	if err := core.InMaintOrDecomm(smap, core.T.Snode(), p.xctn); err != nil {
 		return err
@@ -100,7 +102,7 @@ func (p *streamingF) newDM(trname string, recv transport.RecvObj, config *cmn.Co
 	}
 
 	// consider adding config.X.Compression, config.X.SbundleMult (currently, always 1), etc.
-	dmxtra := bundle.Extra{Config: config, Multiplier: 1, SizePDU: sizePDU}
+	dmxtra := bundle.Extra{RecvAck: recvAck, Config: config, Multiplier: 1, SizePDU: sizePDU, WindowSize: window}
 	p.dm, err = bundle.NewDataMover(trname, recv, owt, dmxtra)
 	if err != nil {
 		return err
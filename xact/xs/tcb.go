@@ -6,6 +6,7 @@
 package xs
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"sync"
@@ -47,11 +48,52 @@ type (
 		nam, str string
 		wg       sync.WaitGroup // starting up
 		refc     atomic.Int32   // finishing
+		skipped  atomic.Int64   // unchanged or (skip-existing) existing destination objects
+		failed   atomic.Int64   // failed to copy
+		bw       bwlim          // optional bandwidth limit
+		errNames errNames       // names of the objects that failed to copy (to retry)
+		moved    moveAcks       // (when moving, i.e. deleting sources)
+	}
+	// bandwidth limiter: sleep whenever copying gets ahead of the configured rate
+	bwlim struct {
+		total   atomic.Int64
+		started atomic.Int64 // mono time of the first copied object (no initial burst credit)
+		bps     int64        // bytes per second; zero - unlimited
+	}
+	// move (CopyBckMsg.DeleteSrc) via data mover: the source object gets deleted only
+	// upon the receiving target's ACK (i.e., after the latter has stored the copy)
+	moveAcks struct {
+		cnt atomic.Int64 // sent and not yet acknowledged
+	}
+	// (up to maxErrNames) source object names
+	errNames struct {
+		names []string
+		mu    sync.Mutex
+	}
+	// x-tcb and x-tco extended stats
+	ExtTCStats struct {
+		FailedNames []string `json:"err.names,omitempty"` // (up to maxSnapErrNames per target - see apc.WhatXactErrNames)
+		SkippedCnt  int64    `json:"skip.n,string"`
+		FailedCnt   int64    `json:"err.n,string"`
+		InflightSz  int64    `json:"inflight.size,string,omitempty"` // see bundle.Extra.WindowSize
+		// data (opcode zero) vs. control traffic, e.g. OpcTxnDone
+		Opcodes map[int]bundle.OpcStat `json:"opcodes,omitempty"`
 	}
 )
 
+// max number of failed-to-copy object names per target:
+// - kept in memory and returned upon explicit request (apc.WhatXactErrNames);
+// - included in each ExtTCStats snapshot
+const (
+	maxErrNames     = 1000
+	maxSnapErrNames = 10
+)
+
 const OpcTxnDone = 27182
 
+// (receiver => sender) failed to store moved object - the sender keeps the source (see moveAcks)
+const opcMoveNack = 27183
+
 const etlBucketParallelCnt = 2
 
 // interface guard
@@ -105,11 +147,14 @@ func (p *tcbFactory) Start() error {
 func (p *tcbFactory) newDM(config *cmn.Config, uuid string, sizePDU int32) error {
 	const trname = "tcb"
 	dmExtra := bundle.Extra{
-		RecvAck:     nil, // no ACKs
 		Config:      config,
 		Compression: config.TCB.Compression,
 		Multiplier:  config.TCB.SbundleMult,
 		SizePDU:     sizePDU,
+		WindowSize:  p.args.Msg.WindowSize,
+	}
+	if p.args.Msg.DeleteSrc {
+		dmExtra.RecvAck = p.xctn.recvAck
 	}
 	// in re cmn.OwtPut: see comment inside _recv()
 	dm, err := bundle.NewDataMover(trname+"-"+uuid, p.xctn.recv, p.owt, dmExtra)
@@ -165,7 +210,10 @@ func newTCB(p *tcbFactory, slab *memsys.Slab, config *cmn.Config, smap *meta.Sma
 	r.str = r.Base.String() + " <= " + s2 + s1
 
 	var parallel int
-	if p.kind == apc.ActETLBck {
+	switch {
+	case p.args.Msg.NumWorkers > 0:
+		parallel = min(p.args.Msg.NumWorkers, cmn.MaxParallelism())
+	case p.kind == apc.ActETLBck:
 		parallel = etlBucketParallelCnt // TODO: optimize with respect to disk bw and transforming computation
 	}
 	mpopts := &mpather.JgroupOpts{
@@ -179,6 +227,7 @@ func newTCB(p *tcbFactory, slab *memsys.Slab, config *cmn.Config, smap *meta.Sma
 	}
 	mpopts.Bck.Copy(p.args.BckFrom.Bucket())
 	r.BckJog.Init(p.UUID(), p.kind, p.args.BckTo, mpopts, config)
+	r.bw.init(p.args.Msg.BwLimit)
 
 	if p.args.Msg.Sync {
 		debug.Assert(p.args.Msg.Prepend == "", p.args.Msg.Prepend) // validated (cli, P)
@@ -222,6 +271,7 @@ func (r *XactTCB) Run(wg *sync.WaitGroup) {
 		if q == core.QuiTimeout {
 			r.AddErr(fmt.Errorf("%s: %v", r, cmn.ErrQuiesceTimeout))
 		}
+		r.moved.wait(r, r.Config.Timeout.SendFile.D())
 
 		// close
 		r.dm.Close(err)
@@ -261,6 +311,9 @@ func (r *XactTCB) do(lom *core.LOM, buf []byte) (err error) {
 		args   = r.p.args // TCBArgs
 		toName = args.Msg.ToName(lom.ObjName)
 	)
+	if !args.Msg.Selected(lom.ObjName) {
+		return nil // filtered out (include/exclude)
+	}
 	if cmn.Rom.FastV(5, cos.SmoduleXs) {
 		nlog.Infoln(r.Base.Name()+":", lom.Cname(), "=>", args.BckTo.Cname(toName))
 	}
@@ -276,20 +329,36 @@ func (r *XactTCB) do(lom *core.LOM, buf []byte) (err error) {
 		coiParams.DryRun = args.Msg.DryRun
 		coiParams.LatestVer = args.Msg.LatestVer
 		coiParams.Sync = args.Msg.Sync
+		coiParams.Overwrite = args.Msg.Overwrite
+		coiParams.SkipExist = args.Msg.SkipExisting
+		coiParams.DeleteSrc = args.Msg.DeleteSrc
 	}
-	_, err = core.T.CopyObject(lom, r.dm, coiParams)
+	size, err := core.T.CopyObject(lom, r.dm, coiParams)
 	core.FreeCOI(coiParams)
+	r.bw.add(size)
+	if err == cmn.ErrSkip {
+		r.skipped.Inc()
+		err = nil
+	}
 	switch {
 	case err == nil:
 		if args.Msg.Sync {
 			r.prune.filter.Insert(cos.UnsafeB(lom.Uname()))
 		}
+		if args.Msg.DeleteSrc && r.dm != nil && !args.Msg.DryRun {
+			r.moved.sent(args.BckTo, toName)
+		}
 	case cos.IsNotExist(err, 0):
 		// do nothing
 	case cos.IsErrOOS(err):
 		r.Abort(err)
 	default:
+		r.failed.Inc()
+		r.errNames.add(lom.ObjName)
 		r.AddErr(err, 5, cos.SmoduleXs)
+		if args.Msg.ContinueOnError {
+			err = nil // keep traversing
+		}
 	}
 	return
 }
@@ -318,6 +387,7 @@ func (r *XactTCB) recv(hdr *transport.ObjHdr, objReader io.Reader, err error) er
 func (r *XactTCB) _recv(hdr *transport.ObjHdr, objReader io.Reader, lom *core.LOM) error {
 	if err := lom.InitBck(&hdr.Bck); err != nil {
 		r.AddErr(err, 0)
+		ackMoved(r.dm, hdr, err)
 		return err
 	}
 	lom.CopyAttrs(&hdr.ObjAttrs, true /*skip cksum*/)
@@ -340,12 +410,18 @@ func (r *XactTCB) _recv(hdr *transport.ObjHdr, objReader io.Reader, lom *core.LO
 	core.FreePutParams(params)
 	if erp != nil {
 		r.AddErr(erp, 0)
+		ackMoved(r.dm, hdr, erp)
 		return erp // NOTE: non-nil signals transport to terminate
 	}
 	r.rxlast.Store(mono.NanoTime())
+	ackMoved(r.dm, hdr, nil)
 	return nil
 }
 
+func (r *XactTCB) recvAck(hdr *transport.ObjHdr, _ io.Reader, err error) error {
+	return r.moved.recv(r, hdr, err)
+}
+
 func (r *XactTCB) Args() *xreg.TCBArgs { return r.p.args }
 
 func (r *XactTCB) _str() (s string) {
@@ -362,6 +438,12 @@ func (r *XactTCB) _str() (s string) {
 	if msg.Sync {
 		s = ", synchronize"
 	}
+	if msg.SkipExisting {
+		s = ", skip-existing"
+	}
+	if msg.DeleteSrc {
+		s += ", delete-src"
+	}
 	return s
 }
 
@@ -372,6 +454,9 @@ func (r *XactTCB) FromTo() (*meta.Bck, *meta.Bck) {
 	return r.p.args.BckFrom, r.p.args.BckTo
 }
 
+// (up to maxErrNames - see apc.WhatXactErrNames)
+func (r *XactTCB) FailedNames() []string { return r.errNames.get(maxErrNames) }
+
 func (r *XactTCB) Snap() (snap *core.Snap) {
 	snap = &core.Snap{}
 	r.ToSnap(snap)
@@ -379,5 +464,133 @@ func (r *XactTCB) Snap() (snap *core.Snap) {
 	snap.IdleX = r.IsIdle()
 	f, t := r.FromTo()
 	snap.SrcBck, snap.DstBck = f.Clone(), t.Clone()
+	ext := &ExtTCStats{FailedNames: r.errNames.get(maxSnapErrNames), SkippedCnt: r.skipped.Load(), FailedCnt: r.failed.Load()}
+	if r.dm != nil {
+		ext.InflightSz = r.dm.InflightBytes()
+		ext.Opcodes = r.dm.OpcodeStats()
+	}
+	snap.Ext = ext
+	if r.p.args.Msg.ContinueOnError {
+		// all (up to a limit) failures, one per line
+		if _, err := r.JoinErr(); err != nil {
+			snap.Err = err.Error()
+		}
+	}
 	return
 }
+
+//////////////
+// errNames //
+//////////////
+
+func (e *errNames) add(name string) {
+	e.mu.Lock()
+	if len(e.names) < maxErrNames {
+		e.names = append(e.names, name)
+	}
+	e.mu.Unlock()
+}
+
+func (e *errNames) get(limit int) (names []string) {
+	e.mu.Lock()
+	if n := min(len(e.names), limit); n > 0 {
+		names = make([]string, n)
+		copy(names, e.names)
+	}
+	e.mu.Unlock()
+	return names
+}
+
+//////////////
+// moveAcks //
+//////////////
+
+// (sender) count objects sent to other targets
+func (ma *moveAcks) sent(bckTo *meta.Bck, objNameTo string) {
+	smap := core.T.Sowner().Get()
+	if tsi, err := smap.HrwName2T(bckTo.MakeUname(objNameTo)); err == nil && tsi.ID() != core.T.SID() {
+		ma.cnt.Inc()
+	}
+}
+
+// (sender) delete the source object upon receiver's ACK;
+// every outcome (including NACK) counts - see wait()
+func (ma *moveAcks) recv(xctn core.Xact, hdr *transport.ObjHdr, err error) error {
+	if err != nil && !cos.IsEOF(err) {
+		nlog.Errorln(err)
+		if hdr != nil && len(hdr.Opaque) > 0 {
+			ma.cnt.Dec() // keeping the source
+		}
+		return err
+	}
+	ma.cnt.Dec()
+	bck, objName := cmn.ParseUname(string(hdr.Opaque))
+	if hdr.Opcode == opcMoveNack {
+		xctn.AddErr(fmt.Errorf("%s: failed to move %s (rejected by %s) - keeping the source",
+			xctn, bck.Cname(objName), meta.Tname(hdr.SID)), 4, cos.SmoduleXs)
+		return nil
+	}
+	lom := core.AllocLOM(objName)
+	if err := lom.InitBck(&bck); err != nil {
+		xctn.AddErr(err, 4, cos.SmoduleXs)
+	} else if _, err := core.T.DeleteObject(lom, false /*evict*/); err != nil && !cos.IsNotExist(err, 0) {
+		xctn.AddErr(fmt.Errorf("failed to delete moved source %s: %w", lom.Cname(), err), 4, cos.SmoduleXs)
+	}
+	core.FreeLOM(lom)
+	return nil
+}
+
+// (sender) before closing the data mover; unacknowledged sources are not deleted
+func (ma *moveAcks) wait(xctn core.Xact, timeout time.Duration) {
+	const sleep = 100 * time.Millisecond
+	for total := time.Duration(0); ma.cnt.Load() > 0 && !xctn.IsAborted(); total += sleep {
+		if total > timeout {
+			nlog.Warningln(xctn.Name(), "timed out waiting for", ma.cnt.Load(), "move ACKs (keeping the sources)")
+			return
+		}
+		time.Sleep(sleep)
+	}
+}
+
+// (receiver) upon storing the object: ACK the sender (see coi._dm);
+// NACK when failed to store
+func ackMoved(dm *bundle.DataMover, hdr *transport.ObjHdr, errRx error) {
+	if len(hdr.Opaque) == 0 || dm == nil {
+		return
+	}
+	tsi := core.T.Sowner().Get().GetTarget(hdr.SID)
+	if tsi == nil {
+		nlog.Errorln("cannot ACK moved", hdr.Cname(), "-", meta.Tname(hdr.SID), "is not present in the cluster map")
+		return
+	}
+	hdr.Opaque = bytes.Clone(hdr.Opaque) // (not to reference transport's buffer)
+	hdr.ObjAttrs.Size = 0
+	if errRx != nil {
+		hdr.Opcode = opcMoveNack
+	}
+	if err := dm.ACK(hdr, nil, tsi); err != nil {
+		nlog.Errorln(err)
+	}
+}
+
+///////////
+// bwlim //
+///////////
+
+func (bw *bwlim) init(bps int64) { bw.bps = bps }
+
+func (bw *bwlim) add(size int64) {
+	if bw.bps <= 0 || size <= 0 {
+		return
+	}
+	if bw.started.Load() == 0 {
+		bw.started.CAS(0, mono.NanoTime())
+	}
+	var (
+		total    = bw.total.Add(size)
+		expected = time.Duration(float64(total) / float64(bw.bps) * float64(time.Second))
+	)
+	if d := expected - mono.Since(bw.started.Load()); d > 0 {
+		time.Sleep(d)
+	}
+}
@@ -0,0 +1,45 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/transport"
+)
+
+// failing receiver: every sent object gets accounted for (NACK or ACK stream error),
+// and the sender does not wait out the timeout
+func TestMoveAcksFailingReceiver(t *testing.T) {
+	var (
+		ma    moveAcks
+		xctn  = mock.NewXact(apc.ActMoveBck)
+		bck   = cmn.Bck{Name: "src", Provider: apc.AIS, Ns: cmn.NsGlobal}
+		uname = []byte(bck.MakeUname("obj"))
+	)
+	ma.cnt.Store(2)
+
+	// receiver failed to store
+	err := ma.recv(xctn, &transport.ObjHdr{Opcode: opcMoveNack, Opaque: uname, SID: "t1"}, nil)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, ma.cnt.Load() == 1, "expected 1 pending ACK, got %d", ma.cnt.Load())
+	tassert.Errorf(t, xctn.Err() != nil, "expected NACK to be recorded as xaction error")
+
+	// ACK stream error
+	err = ma.recv(xctn, &transport.ObjHdr{Opaque: uname}, errors.New("broken pipe"))
+	tassert.Errorf(t, err != nil, "expected error")
+	tassert.Errorf(t, ma.cnt.Load() == 0, "expected no pending ACKs, got %d", ma.cnt.Load())
+
+	started := time.Now()
+	ma.wait(xctn, time.Minute)
+	tassert.Errorf(t, time.Since(started) < time.Second, "waited %v with no pending ACKs", time.Since(started))
+}
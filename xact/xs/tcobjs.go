@@ -42,6 +42,12 @@ type (
 		args     *xreg.TCObjsArgs
 		workCh   chan *cmn.TCObjsMsg
 		chanFull atomic.Int64
+		skipped  atomic.Int64 // unchanged or (skip-existing) existing destination objects
+		failed   atomic.Int64 // failed to copy
+		errNames errNames     // names of the objects that failed to copy (to retry)
+		moved    moveAcks     // (when moving, i.e. deleting sources)
+		avgSize  cos.SizeEWMA // to size copy buffers
+		bw       bwlim        // optional bandwidth limit (shared by all work items)
 		streamingX
 		owt cmn.OWT
 	}
@@ -84,6 +90,7 @@ func (p *tcoFactory) Start() error {
 	workCh := make(chan *cmn.TCObjsMsg, maxNumInParallel)
 	r := &XactTCObjs{streamingX: streamingX{p: &p.streamingF, config: cmn.GCO.Get()}, args: p.args, workCh: workCh}
 	r.pending.m = make(map[string]*tcowi, maxNumInParallel)
+	r.bw.init(p.args.BwLimit)
 	r.owt = cmn.OwtCopy
 	if p.kind == apc.ActETLObjects {
 		r.owt = cmn.OwtTransform
@@ -98,7 +105,8 @@ func (p *tcoFactory) Start() error {
 		sizePDU = memsys.DefaultBufSize
 	}
 
-	if err := p.newDM(p.Args.UUID /*trname*/, r.recv, r.config, r.owt, sizePDU); err != nil {
+	// (ACKs: when moving objects, see moveAcks)
+	if err := p.newDM(p.Args.UUID /*trname*/, r.recv, r.recvAck, r.config, r.owt, sizePDU, p.args.WindowSize); err != nil {
 		return err
 	}
 
@@ -124,6 +132,9 @@ func (r *XactTCObjs) String() string {
 
 func (r *XactTCObjs) FromTo() (*meta.Bck, *meta.Bck) { return r.args.BckFrom, r.args.BckTo }
 
+// (up to maxErrNames - see apc.WhatXactErrNames)
+func (r *XactTCObjs) FailedNames() []string { return r.errNames.get(maxErrNames) }
+
 func (r *XactTCObjs) Snap() (snap *core.Snap) {
 	snap = &core.Snap{}
 	r.ToSnap(snap)
@@ -131,6 +142,12 @@ func (r *XactTCObjs) Snap() (snap *core.Snap) {
 	snap.IdleX = r.IsIdle()
 	f, t := r.FromTo()
 	snap.SrcBck, snap.DstBck = f.Clone(), t.Clone()
+	ext := &ExtTCStats{FailedNames: r.errNames.get(maxSnapErrNames), SkippedCnt: r.skipped.Load(), FailedCnt: r.failed.Load()}
+	if r.p.dm != nil {
+		ext.InflightSz = r.p.dm.InflightBytes()
+		ext.Opcodes = r.p.dm.OpcodeStats()
+	}
+	snap.Ext = ext
 	return
 }
 
@@ -198,6 +215,9 @@ func (r *XactTCObjs) Run(wg *sync.WaitGroup) {
 		}
 	}
 fin:
+	if r.p.dm != nil {
+		r.moved.wait(r, r.config.Timeout.SendFile.D())
+	}
 	r.fin(true /*unreg Rx*/)
 	if r.Err() != nil {
 		// cleanup: destroy destination iff it was created by this copy
@@ -272,6 +292,7 @@ func (r *XactTCObjs) _recv(hdr *transport.ObjHdr, objReader io.Reader) error {
 
 func (r *XactTCObjs) _put(hdr *transport.ObjHdr, objReader io.Reader, lom *core.LOM) (err error) {
 	if err = lom.InitBck(&hdr.Bck); err != nil {
+		ackMoved(r.p.dm, hdr, err)
 		return
 	}
 	lom.CopyAttrs(&hdr.ObjAttrs, true /*skip cksum*/)
@@ -294,24 +315,35 @@ func (r *XactTCObjs) _put(hdr *transport.ObjHdr, objReader io.Reader, lom *core.
 
 	if err != nil {
 		r.AddErr(err, 5, cos.SmoduleXs)
-	} else if cmn.Rom.FastV(5, cos.SmoduleXs) {
+		ackMoved(r.p.dm, hdr, err)
+		return
+	}
+	if cmn.Rom.FastV(5, cos.SmoduleXs) {
 		nlog.Infof("%s: tco-Rx %s, size=%d", r.Base.Name(), lom.Cname(), hdr.ObjAttrs.Size)
 	}
+	ackMoved(r.p.dm, hdr, nil)
 	return
 }
 
+func (r *XactTCObjs) recvAck(hdr *transport.ObjHdr, _ io.Reader, err error) error {
+	return r.moved.recv(r, hdr, err)
+}
+
 ///////////
 // tcowi //
 ///////////
 
 func (wi *tcowi) do(lom *core.LOM, lrit *lriterator) {
+	if !wi.msg.Selected(lom.ObjName) {
+		return // filtered out (include/exclude)
+	}
 	var (
 		objNameTo = wi.msg.ToName(lom.ObjName)
-		buf, slab = core.T.PageMM().Alloc()
+		buf, slab = core.T.PageMM().AllocSize(wi.r.avgSize.Get(memsys.DefaultBufSize))
 	)
 
 	// under ETL, the returned sizes of transformed objects are unknown (`cos.ContentLengthUnknown`)
-	// until after the transformation; here we are disregarding the size anyway as the stats
+	// until after the transformation; here we are disregarding the size (other than for bandwidth limiting) as the stats
 	// are done elsewhere
 
 	coiParams := core.AllocCOI()
@@ -326,13 +358,29 @@ func (wi *tcowi) do(lom *core.LOM, lrit *lriterator) {
 		coiParams.DryRun = wi.msg.DryRun
 		coiParams.LatestVer = wi.msg.LatestVer
 		coiParams.Sync = wi.msg.Sync
+		coiParams.Overwrite = wi.msg.Overwrite
+		coiParams.SkipExist = wi.msg.SkipExisting
+		coiParams.DeleteSrc = wi.msg.DeleteSrc
 	}
-	_, err := core.T.CopyObject(lom, wi.r.p.dm, coiParams)
+	size, err := core.T.CopyObject(lom, wi.r.p.dm, coiParams)
 	core.FreeCOI(coiParams)
 	slab.Free(buf)
+	wi.r.bw.add(size)
+	if err == nil {
+		wi.r.avgSize.Add(size)
+	}
 
+	if err == cmn.ErrSkip {
+		wi.r.skipped.Inc()
+		return
+	}
+	if err == nil && wi.msg.DeleteSrc && wi.r.p.dm != nil && !wi.msg.DryRun {
+		wi.r.moved.sent(wi.r.args.BckTo, objNameTo)
+	}
 	if err != nil {
 		if !cos.IsNotExist(err, 0) || lrit.lrp == lrpList {
+			wi.r.failed.Inc()
+			wi.r.errNames.add(lom.ObjName)
 			wi.r.AddErr(err, 5, cos.SmoduleXs)
 		}
 	} else if cmn.Rom.FastV(5, cos.SmoduleXs) {